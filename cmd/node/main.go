@@ -7,13 +7,17 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/dravanet/truenas-csi/pkg/config"
 	"github.com/dravanet/truenas-csi/pkg/controller"
 	"github.com/dravanet/truenas-csi/pkg/csi"
 	"github.com/dravanet/truenas-csi/pkg/identity"
 	"github.com/dravanet/truenas-csi/pkg/node"
+	"github.com/dravanet/truenas-csi/pkg/nodeid"
 
 	"github.com/namsral/flag"
 	"google.golang.org/grpc"
@@ -31,7 +35,21 @@ func main() {
 
 	csiEndpoint := flag.String("csi-endpoint", "unix:///csi/csi.sock", "CSI Endpoint address")
 	csiNodeId := flag.String("csi-node-id", hostname, "CSI Node ID reported in NodeInfo")
+	csiNodeAddress := flag.String("csi-node-address", "", "Node address to grant access to shares for, reported in NodeInfo")
+	csiNodeTopology := flag.String("csi-node-topology", "", "Topology segments reported in NodeInfo, in key=value[,key=value...] format")
+	csiNodeTopologyFile := flag.String("csi-node-topology-file", "", "File holding node labels in key=value lines, e.g. from downward API")
+	csiNodeTopologyKeys := flag.String("csi-node-topology-keys", "", "Comma separated list of keys to report as topology segments from -csi-node-topology-file")
+	iscsiInitiatorNameFile := flag.String("iscsi-initiatorname-file", "/host/etc/iscsi/initiatorname.iscsi", "File holding the node's iSCSI initiator name, reported in NodeInfo")
+	csiNodeIdIQN := flag.Bool("csi-node-id-iqn", false, "Report the iSCSI initiator name in the node id, needed by iscsi restrictToNodes. Changes the node id of existing nodes")
+	nfsKrb5Keytab := flag.String("nfs-krb5-keytab", "", "Keytab to start rpc.gssd with for kerberized nfs mounts, a host rpc.gssd is used if empty")
 	controllerConfig := flag.String("controller-config", "", "Configuration for CSI, enables Controller services")
+	controllerConfigReloadInterval := flag.Duration("controller-config-reload-interval", 10*time.Second, "Interval -controller-config is checked for changes at, 0 reloads on SIGHUP only")
+	controllerUnlockInterval := flag.Duration("controller-unlock-interval", 5*time.Minute, "Interval locked root datasets with an unlockKey are unlocked at, 0 unlocks at startup and reload only")
+	ephemeralConfig := flag.String("ephemeral-config", "", "Configuration for CSI, enables inline ephemeral volumes on the node")
+	ephemeralDir := flag.String("ephemeral-dir", "/var/lib/kubelet/plugins/truenas-csi.dravanet.net/ephemeral", "Directory holding state of inline ephemeral volumes")
+	ephemeralMaxSize := flag.String("ephemeral-max-size", "10Gi", "Maximum size of inline ephemeral volumes, e.g. 10Gi, unlimited if 0")
+	ephemeralAttributes := flag.String("ephemeral-allowed-attributes", "", "Comma separated list of volume attributes inline ephemeral volumes may set besides size, e.g. compression,sparse")
+	iscsiStateDir := flag.String("iscsi-state-dir", "/var/lib/kubelet/plugins/truenas-csi.dravanet.net/iscsi", "Directory recording iSCSI luns staged on the node")
 	tlsCert := flag.String("tls-cert", "", "TLS Certificate")
 	tlsKey := flag.String("tls-key", "", "TLS Private key")
 	tlsCA := flag.String("tls-ca", "", "TLS Certificate Authority")
@@ -39,20 +57,11 @@ func main() {
 	flag.Parse()
 
 	var controllerServer csi.ControllerServer
+	var groupControllerServer csi.GroupControllerServer
+	var controllerTopology bool
 
 	if *controllerConfig != "" {
-		cfgData, err := os.ReadFile(*controllerConfig)
-		if err != nil {
-			log.Fatal(err)
-		}
-		var cfg config.CSIConfiguration
-		if err = yaml.Unmarshal(cfgData, &cfg); err != nil {
-			log.Fatal(err)
-		}
-
-		if err = cfg.Validate(); err != nil {
-			log.Fatal(err)
-		}
+		cfg := readConfig(*controllerConfig)
 
 		ser, err := yaml.Marshal(&cfg)
 		if err != nil {
@@ -60,11 +69,53 @@ func main() {
 		}
 		fmt.Println(string(ser))
 
-		controllerServer = controller.New(cfg)
+		controllerTopology = cfg.HasTopology()
+
+		store := config.NewStore(cfg)
+		controllerServer = controller.New(store)
+		groupControllerServer = controller.NewGroupController(store)
+
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go store.Watch(*controllerConfig, *controllerConfigReloadInterval, hup, func(previous, next config.CSIConfiguration) {
+			controller.WarnRemovedVolumes(previous, next)
+			controller.UnlockRootDatasets(next)
+		})
+
+		go func() {
+			controller.UnlockRootDatasets(store.Load())
+			if *controllerUnlockInterval <= 0 {
+				return
+			}
+
+			for range time.Tick(*controllerUnlockInterval) {
+				controller.UnlockRootDatasets(store.Load())
+			}
+		}()
+	}
+
+	// Inline ephemeral volumes are provisioned by the node itself
+	var provisioner csi.ControllerServer
+	if *ephemeralConfig != "" {
+		provisioner = controller.New(config.NewStore(readConfig(*ephemeralConfig)))
+	}
+
+	maxSize, err := node.ParseSize(*ephemeralMaxSize)
+	if err != nil {
+		log.Fatalf("Invalid -ephemeral-max-size %q: %+v", *ephemeralMaxSize, err)
+	}
+
+	var allowedAttributes []string
+	for _, attribute := range strings.Split(*ephemeralAttributes, ",") {
+		if attribute = strings.TrimSpace(attribute); attribute != "" {
+			if !strings.HasPrefix(attribute, config.PropertyPrefix) {
+				attribute = config.PropertyPrefix + attribute
+			}
+			allowedAttributes = append(allowedAttributes, attribute)
+		}
 	}
 
 	var lis net.Listener
-	var err error
 	var opts []grpc.ServerOption
 
 	if *tlsCert != "" && *tlsKey != "" {
@@ -112,15 +163,63 @@ func main() {
 
 	server := grpc.NewServer(opts...)
 
-	identityServer := identity.New(controllerServer != nil)
-	csi.RegisterIdentityServer(server, identityServer)
-
 	if controllerServer != nil {
 		csi.RegisterControllerServer(server, controllerServer)
+		csi.RegisterGroupControllerServer(server, groupControllerServer)
 	}
 
-	nodeServer := node.New(*csiNodeId)
+	var initiatorName string
+	if *csiNodeIdIQN {
+		if initiatorName, err = node.ReadInitiatorName(*iscsiInitiatorNameFile); err != nil {
+			log.Printf("iSCSI initiator name not available: %+v", err)
+		}
+	}
+
+	topology, err := node.ParseTopology(*csiNodeTopology)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *csiNodeTopologyFile != "" {
+		segments, err := node.ReadTopologyFile(*csiNodeTopologyFile, strings.Split(*csiNodeTopologyKeys, ","))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for key, value := range segments {
+			topology[key] = value
+		}
+	}
+
+	nodeID := (&nodeid.Node{
+		Name:    *csiNodeId,
+		Address: *csiNodeAddress,
+		IQN:     initiatorName,
+	}).String()
+	if len(nodeID) > nodeid.MaxLength {
+		log.Fatalf("Node id %q is longer than %d bytes", nodeID, nodeid.MaxLength)
+	}
+
+	identityServer := identity.New(controllerServer != nil, controllerTopology || len(topology) > 0)
+	csi.RegisterIdentityServer(server, identityServer)
+
+	nodeServer := node.New(nodeID, topology, *nfsKrb5Keytab, provisioner, *ephemeralDir, maxSize, allowedAttributes, *iscsiStateDir)
 	csi.RegisterNodeServer(server, nodeServer)
 
 	server.Serve(lis)
 }
+
+// readConfig reads and validates a CSI configuration file
+func readConfig(file string) config.CSIConfiguration {
+	cfgData, err := os.ReadFile(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cfg, err := config.Parse(cfgData)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return cfg
+}
@@ -1,15 +1,38 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 )
 
 // CSIConfiguration
 type CSIConfiguration map[string]*FreeNAS
 
 const (
-	NasSelector    = "truenas-csi.dravanet.net/nas"
-	ConfigSelector = "truenas-csi.dravanet.net/config"
+	NasSelector      = "truenas-csi.dravanet.net/nas"
+	ConfigSelector   = "truenas-csi.dravanet.net/config"
+	ProtocolSelector = "truenas-csi.dravanet.net/protocol"
+
+	// Parameters overriding Permissions of filesystem volumes
+	UserParameter  = "truenas-csi.dravanet.net/user"
+	GroupParameter = "truenas-csi.dravanet.net/group"
+	ModeParameter  = "truenas-csi.dravanet.net/mode"
+	ACLParameter   = "truenas-csi.dravanet.net/acl"
+
+	// EncryptionParameter requests native zfs encryption, with key material from provisioner secrets
+	EncryptionParameter = "truenas-csi.dravanet.net/encryption"
+)
+
+// Protocols selectable with ProtocolSelector
+const (
+	ProtocolNFS   = "nfs"
+	ProtocolISCSI = "iscsi"
+	ProtocolSMB   = "smb"
+
+	// ProtocolAuto selects the first allowed protocol providing the requested capabilities
+	ProtocolAuto = "auto"
 )
 
 // FreeNAS API access parameters
@@ -29,8 +52,15 @@ type FreeNAS struct {
 	// ISCSI holds global iSCSI configuration
 	ISCSI *ISCSI `yaml:"iscsi,omitempty"`
 
+	// SMB holds global smb configuration
+	SMB *SMB `yaml:"smb,omitempty"`
+
 	Configurations map[string]*Configuration `yaml:"configurations,omitempty"`
 
+	// Topology lists topology segments the NAS is accessible from.
+	// If empty, the NAS is accessible from everywhere.
+	Topology []map[string]string `yaml:"topology,omitempty"`
+
 	name                  string
 	rootDsToConfiguration map[string]*Configuration
 }
@@ -40,6 +70,29 @@ func (nas *FreeNAS) Name() string {
 	return nas.name
 }
 
+// AccessibleFrom returns true if NAS is accessible from a node having topology segments
+func (nas *FreeNAS) AccessibleFrom(segments map[string]string) bool {
+	if len(nas.Topology) == 0 {
+		return true
+	}
+
+	for _, topology := range nas.Topology {
+		matches := true
+		for key, value := range topology {
+			if segments[key] != value {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
 // DeletePolicy specifies delete policy for a configuration
 type DeletePolicy string
 
@@ -48,7 +101,7 @@ const (
 	DeletePolicyRetain DeletePolicy = "retain"
 )
 
-// Configuration holds common configuration for nfs/iscsi shares
+// Configuration holds common configuration for nfs/iscsi/smb shares
 type Configuration struct {
 	// Dataset specifies the dataset to hold volumes
 	Dataset string `yaml:"dataset"`
@@ -65,6 +118,64 @@ type Configuration struct {
 
 	// ISCSI holds iSCSI sub-configuration
 	ISCSI *ISCSI `yaml:"iscsi,omitempty"`
+
+	// SMB holds smb sub-configuration
+	SMB *SMB `yaml:"smb,omitempty"`
+
+	// Permissions holds the default ownership and access of filesystem volumes
+	Permissions *Permissions `yaml:"permissions,omitempty"`
+
+	// Properties holds default zfs properties of volumes, e.g. compression: lz4
+	Properties map[string]string `yaml:"properties,omitempty"`
+
+	// AllowedProtocols restricts protocols volumes may be shared over, all are allowed if empty
+	AllowedProtocols []string `yaml:"allowedProtocols,omitempty"`
+
+	// UnlockKey is the source of the key unlocking Dataset, or its encrypted parent, when locked
+	UnlockKey *UnlockKey `yaml:"unlockKey,omitempty"`
+}
+
+// Permissions holds ownership and access of a filesystem volume's root
+type Permissions struct {
+	User  string `yaml:"user,omitempty"`
+	Group string `yaml:"group,omitempty"`
+
+	// Mode is an octal mode, 0777 by default, or 2770 if Group is set
+	Mode string `yaml:"mode,omitempty"`
+
+	// ACL is a JSON list of NFSv4 or POSIX ACL entries in TrueNAS format, applied instead of Mode
+	ACL string `yaml:"acl,omitempty"`
+}
+
+var modeRe = regexp.MustCompile(`^[0-7]{3,4}$`)
+
+// Validate checks mode and acl of permissions
+func (p *Permissions) Validate() error {
+	if p.Mode != "" && p.ACL != "" {
+		return fmt.Errorf("Invalid permissions: mode and acl are exclusive")
+	}
+
+	if p.Mode != "" && !modeRe.MatchString(p.Mode) {
+		return fmt.Errorf("Invalid permissions mode: %q", p.Mode)
+	}
+
+	if p.ACL != "" {
+		if _, err := p.ACLEntries(); err != nil {
+			return fmt.Errorf("Invalid permissions acl: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+// ACLEntries returns the parsed ACL entries
+func (p *Permissions) ACLEntries() ([]map[string]interface{}, error) {
+	var entries []map[string]interface{}
+	if err := json.Unmarshal([]byte(p.ACL), &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
 }
 
 // NFS holds configuration for Filesystem Volumes
@@ -73,14 +184,87 @@ type NFS struct {
 
 	AllowedHosts    []string `yaml:"allowedhosts"`
 	AllowedNetworks []string `yaml:"allowednetworks"`
+
+	// RestrictToNodes exports shares only to nodes the volume is published to,
+	// instead of AllowedHosts and AllowedNetworks
+	RestrictToNodes bool `yaml:"restrictToNodes,omitempty"`
+
+	// Security is the security flavor of shares, one of sys, krb5, krb5i, krb5p.
+	// Kerberized shares are mounted with NFSv4.
+	Security string `yaml:"security,omitempty"`
+
+	// Version is the NFSv4 minor version kerberized shares are mounted with, 4.1 by default
+	Version string `yaml:"version,omitempty"`
+
+	// MaprootUser and MaprootGroup are the credentials root is mapped to, root/wheel by default
+	MaprootUser  string `yaml:"maprootUser,omitempty"`
+	MaprootGroup string `yaml:"maprootGroup,omitempty"`
+
+	// MountOptions are default options nodes mount shares with
+	MountOptions []string `yaml:"mountOptions,omitempty"`
+}
+
+// NFS security flavors
+const (
+	NFSSecuritySys   = "sys"
+	NFSSecurityKrb5  = "krb5"
+	NFSSecurityKrb5i = "krb5i"
+	NFSSecurityKrb5p = "krb5p"
+)
+
+// Kerberized returns true if shares use kerberos security
+func (nfs *NFS) Kerberized() bool {
+	switch nfs.Security {
+	case NFSSecurityKrb5, NFSSecurityKrb5i, NFSSecurityKrb5p:
+		return true
+	}
+
+	return false
 }
 
 // ISCSI holds configuration for Block Volumes
 type ISCSI struct {
-	Portal                 string `yaml:"portal"`
-	PortalID               int    `yaml:"portalid"`
+	Portal   string `yaml:"portal"`
+	PortalID int    `yaml:"portalid"`
+
+	// Portals lists additional addresses of the portal for multipath access
+	Portals []string `yaml:"portals,omitempty"`
+
 	VolBlockSize           string `yaml:"volblocksize,omitempty"`
 	DisableReportBlockSize bool   `yaml:"disableReportBlockSize,omitempty"`
+
+	// RestrictToNodes grants access to targets only for initiators of nodes
+	// the volume is published to
+	RestrictToNodes bool `yaml:"restrictToNodes,omitempty"`
+
+	// SharedTargets packs volumes as luns into this many shared targets,
+	// instead of creating a target for each volume
+	SharedTargets int `yaml:"sharedTargets,omitempty"`
+
+	// MutualCHAP creates targets with mutual CHAP, so that nodes authenticate the NAS as well
+	MutualCHAP bool `yaml:"mutualChap,omitempty"`
+
+	// DiscoveryAuth configures CHAP authentication for discovery on the portal
+	DiscoveryAuth *ISCSIDiscoveryAuth `yaml:"discoveryAuth,omitempty"`
+}
+
+// ISCSIDiscoveryAuth holds CHAP credentials for discovery on the portal
+type ISCSIDiscoveryAuth struct {
+	// Tag is the authorized access group the credentials are stored in
+	Tag      int    `yaml:"tag"`
+	Username string `yaml:"username"`
+	Secret   string `yaml:"secret"`
+
+	// PeerUsername and PeerSecret enable mutual CHAP for discovery
+	PeerUsername string `yaml:"peerUsername,omitempty"`
+	PeerSecret   string `yaml:"peerSecret,omitempty"`
+}
+
+// SMB holds configuration for Filesystem Volumes shared over SMB
+type SMB struct {
+	Server string `yaml:"server"`
+
+	AllowedHosts []string `yaml:"allowedhosts"`
 }
 
 // Validate validates configuration
@@ -96,11 +280,52 @@ func (cfg *CSIConfiguration) Validate() error {
 	return nil
 }
 
+// Names returns the names of configured NASes in sorted order
+func (cfg CSIConfiguration) Names() []string {
+	names := make([]string, 0, len(cfg))
+	for name := range cfg {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// HasTopology returns true if any NAS lists topology segments it is accessible from
+func (cfg CSIConfiguration) HasTopology() bool {
+	for _, nas := range cfg {
+		if len(nas.Topology) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfigurationNames returns the names of configurations in sorted order
+func (nas *FreeNAS) ConfigurationNames() []string {
+	names := make([]string, 0, len(nas.Configurations))
+	for name := range nas.Configurations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 // Validate checks configuration that sane values are specifies.
 // - performs uniqueness check among RootDatasets
 func (nas *FreeNAS) Validate() error {
 	nas.rootDsToConfiguration = make(map[string]*Configuration)
 
+	if err := verifyNFS(nas.NFS); err != nil {
+		return err
+	}
+
+	if err := verifyISCSI(nas.ISCSI); err != nil {
+		return err
+	}
+
 	for _, cfg := range nas.Configurations {
 		if _, ok := nas.rootDsToConfiguration[cfg.Dataset]; ok {
 			return fmt.Errorf("RootDataset \"%s\" is duplicated in configuration", cfg.Dataset)
@@ -110,13 +335,48 @@ func (nas *FreeNAS) Validate() error {
 			return err
 		}
 
-		// use global nfs/iscsi settings
+		if err := verifyNFS(cfg.NFS); err != nil {
+			return err
+		}
+
+		if err := verifyISCSI(cfg.ISCSI); err != nil {
+			return err
+		}
+
+		if cfg.Permissions != nil {
+			if err := cfg.Permissions.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if err := verifyProperties(cfg.Properties); err != nil {
+			return err
+		}
+
+		if cfg.UnlockKey != nil {
+			if err := cfg.UnlockKey.Validate(); err != nil {
+				return err
+			}
+		}
+
+		for _, protocol := range cfg.AllowedProtocols {
+			switch protocol {
+			case ProtocolNFS, ProtocolISCSI, ProtocolSMB:
+			default:
+				return fmt.Errorf("Invalid protocol in allowedProtocols: %q", protocol)
+			}
+		}
+
+		// use global nfs/iscsi/smb settings
 		if cfg.NFS == nil {
 			cfg.NFS = nas.NFS
 		}
 		if cfg.ISCSI == nil {
 			cfg.ISCSI = nas.ISCSI
 		}
+		if cfg.SMB == nil {
+			cfg.SMB = nas.SMB
+		}
 
 		nas.rootDsToConfiguration[cfg.Dataset] = cfg
 	}
@@ -124,12 +384,30 @@ func (nas *FreeNAS) Validate() error {
 	return nil
 }
 
+// GetConfigurationForRootDataset returns the configuration holding volumes under rootds,
+// or nil if rootds is not a configured root dataset.
+func (cfg *FreeNAS) GetConfigurationForRootDataset(rootds string) *Configuration {
+	return cfg.rootDsToConfiguration[rootds]
+}
+
+// GetDeletePolicyForRootDataset returns the delete policy of volumes under rootds,
+// retain if rootds is not a configured root dataset, e.g. after a reload removed it.
 func (cfg *FreeNAS) GetDeletePolicyForRootDataset(rootds string) DeletePolicy {
-	return cfg.rootDsToConfiguration[rootds].DeletePolicy
+	if c := cfg.rootDsToConfiguration[rootds]; c != nil {
+		return c.DeletePolicy
+	}
+
+	return DeletePolicyRetain
 }
 
+// GetSparseForRootDataset returns whether volumes under rootds are sparse,
+// false if rootds is not a configured root dataset.
 func (cfg *FreeNAS) GetSparseForRootDataset(rootds string) bool {
-	return cfg.rootDsToConfiguration[rootds].Sparse
+	if c := cfg.rootDsToConfiguration[rootds]; c != nil {
+		return c.Sparse
+	}
+
+	return false
 }
 
 func verifyDeletePolicy(c *Configuration) error {
@@ -141,3 +419,50 @@ func verifyDeletePolicy(c *Configuration) error {
 
 	return nil
 }
+
+func verifyNFS(nfs *NFS) error {
+	if nfs == nil {
+		return nil
+	}
+
+	switch nfs.Security {
+	case "", NFSSecuritySys, NFSSecurityKrb5, NFSSecurityKrb5i, NFSSecurityKrb5p:
+	default:
+		return fmt.Errorf("Invalid nfs security specified: %q", nfs.Security)
+	}
+
+	switch nfs.Version {
+	case "", "4.0", "4.1", "4.2":
+	default:
+		return fmt.Errorf("Invalid nfs version specified: %q", nfs.Version)
+	}
+
+	return nil
+}
+
+func verifyISCSI(iscsi *ISCSI) error {
+	if iscsi == nil {
+		return nil
+	}
+
+	if iscsi.SharedTargets < 0 {
+		return fmt.Errorf("Invalid iscsi sharedTargets: %d", iscsi.SharedTargets)
+	}
+	if iscsi.SharedTargets > 0 && iscsi.RestrictToNodes {
+		return fmt.Errorf("Invalid iscsi configuration: sharedTargets cannot be used with restrictToNodes")
+	}
+
+	if iscsi.DiscoveryAuth == nil {
+		return nil
+	}
+
+	da := iscsi.DiscoveryAuth
+	if da.Tag <= 0 || da.Username == "" || da.Secret == "" {
+		return fmt.Errorf("Invalid iscsi discoveryAuth: tag, username and secret must be specified")
+	}
+	if da.PeerUsername != "" && da.PeerSecret == "" {
+		return fmt.Errorf("Invalid iscsi discoveryAuth: peerSecret must be specified with peerUsername")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PropertyPrefix is the prefix of StorageClass parameters setting zfs properties, e.g.
+// truenas-csi.dravanet.net/compression
+const PropertyPrefix = "truenas-csi.dravanet.net/"
+
+// ZFS properties settable on volumes
+const (
+	PropertyCompression  = "compression"
+	PropertySync         = "sync"
+	PropertyAtime        = "atime"
+	PropertyRecordsize   = "recordsize"
+	PropertyDedup        = "dedup"
+	PropertyCopies       = "copies"
+	PropertyReadonly     = "readonly"
+	PropertyVolblocksize = "volblocksize"
+	PropertySnapdir      = "snapdir"
+)
+
+var (
+	// propertyValues lists accepted values of properties, as enumerated by the TrueNAS 12 API
+	propertyValues = map[string][]string{
+		PropertyCompression: {
+			"OFF", "LZ4", "LZJB", "ZLE", "GZIP", "GZIP-1", "GZIP-9",
+			"ZSTD", "ZSTD-1", "ZSTD-2", "ZSTD-3", "ZSTD-4", "ZSTD-5", "ZSTD-6", "ZSTD-7", "ZSTD-8", "ZSTD-9", "ZSTD-10",
+			"ZSTD-11", "ZSTD-12", "ZSTD-13", "ZSTD-14", "ZSTD-15", "ZSTD-16", "ZSTD-17", "ZSTD-18", "ZSTD-19",
+			"ZSTD-FAST", "ZSTD-FAST-1", "ZSTD-FAST-2", "ZSTD-FAST-3", "ZSTD-FAST-4", "ZSTD-FAST-5", "ZSTD-FAST-6",
+			"ZSTD-FAST-7", "ZSTD-FAST-8", "ZSTD-FAST-9", "ZSTD-FAST-10", "ZSTD-FAST-20", "ZSTD-FAST-30", "ZSTD-FAST-40",
+			"ZSTD-FAST-50", "ZSTD-FAST-60", "ZSTD-FAST-70", "ZSTD-FAST-80", "ZSTD-FAST-90", "ZSTD-FAST-100",
+			"ZSTD-FAST-500", "ZSTD-FAST-1000",
+		},
+		PropertySync:         {"STANDARD", "ALWAYS", "DISABLED"},
+		PropertyAtime:        {"ON", "OFF"},
+		PropertyRecordsize:   {"512", "1K", "2K", "4K", "8K", "16K", "32K", "64K", "128K", "256K", "512K", "1024K"},
+		PropertyDedup:        {"ON", "OFF", "VERIFY"},
+		PropertyCopies:       {"1", "2", "3"},
+		PropertyReadonly:     {"ON", "OFF"},
+		PropertyVolblocksize: {"512", "1K", "2K", "4K", "8K", "16K", "32K", "64K", "128K"},
+		PropertySnapdir:      {"HIDDEN", "VISIBLE"},
+	}
+)
+
+// ValidateProperty checks a zfs property, returning its value in the form the TrueNAS API expects
+func ValidateProperty(name string, value string) (string, error) {
+	value = strings.ToUpper(value)
+
+	values, ok := propertyValues[name]
+	if !ok {
+		return "", fmt.Errorf("Unsupported property: %s", name)
+	}
+	if !slices.Contains(values, value) {
+		return "", fmt.Errorf("Invalid value for %s: %q", name, value)
+	}
+
+	return value, nil
+}
+
+func verifyProperties(properties map[string]string) error {
+	for name, value := range properties {
+		if _, err := ValidateProperty(name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package config
+
+import "testing"
+
+func TestValidateProperty(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{PropertyCompression, "lz4", "LZ4", false},
+		{PropertyCompression, "zstd-19", "ZSTD-19", false},
+		{PropertyCompression, "zstd-fast-1000", "ZSTD-FAST-1000", false},
+		{PropertyCompression, "gzip-10", "", true},
+		{PropertyCompression, "gzip-5", "", true},
+		{PropertyCompression, "on", "", true},
+		{PropertyCompression, "zstd-fast-11", "", true},
+		{PropertyCompression, "zstd-20", "", true},
+		{PropertySync, "Always", "ALWAYS", false},
+		{PropertyRecordsize, "1024k", "1024K", false},
+		{PropertyRecordsize, "2048K", "", true},
+		{PropertyVolblocksize, "1024K", "", true},
+		{PropertyCopies, "3", "3", false},
+		{PropertyCopies, "4", "", true},
+		{PropertyReadonly, "on", "ON", false},
+		{PropertySnapdir, "visible", "VISIBLE", false},
+		{"quota", "1G", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ValidateProperty(tt.name, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateProperty(%q, %q) error = %v, wantErr %v", tt.name, tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ValidateProperty(%q, %q) = %q, want %q", tt.name, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Store holds a CSIConfiguration which may be replaced while in use. Readers get
+// the configuration current at the time of Load, replacements do not affect it.
+type Store struct {
+	cfg atomic.Pointer[CSIConfiguration]
+}
+
+// NewStore returns a Store holding cfg
+func NewStore(cfg CSIConfiguration) *Store {
+	s := &Store{}
+	s.cfg.Store(&cfg)
+
+	return s
+}
+
+// Load returns the current configuration
+func (s *Store) Load() CSIConfiguration {
+	return *s.cfg.Load()
+}
+
+// Swap replaces the current configuration, returning the previous one
+func (s *Store) Swap(cfg CSIConfiguration) CSIConfiguration {
+	return *s.cfg.Swap(&cfg)
+}
+
+// Parse parses and validates a configuration
+func Parse(data []byte) (CSIConfiguration, error) {
+	var cfg CSIConfiguration
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Watch reloads the configuration from file when its content changes, checked every interval,
+// or when trigger fires, e.g. on SIGHUP. An invalid configuration is rejected, keeping the
+// current one. check is called with the previous and the new configuration after swapping them.
+// Watch does not return.
+func (s *Store) Watch(file string, interval time.Duration, trigger <-chan os.Signal, check func(previous, next CSIConfiguration)) {
+	last, _ := os.ReadFile(file)
+
+	var poll <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		forced := false
+		select {
+		case <-trigger:
+			forced = true
+		case <-poll:
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Printf("Failed reading configuration %s: %+v", file, err)
+			continue
+		}
+		if !forced && bytes.Equal(data, last) {
+			continue
+		}
+		last = data
+
+		next, err := Parse(data)
+		if err == nil && len(next) == 0 {
+			// e.g. a file being written
+			err = fmt.Errorf("no NAS is configured")
+		}
+		if err != nil {
+			log.Printf("Rejected configuration %s, keeping the current one: %+v", file, err)
+			continue
+		}
+
+		previous := s.Swap(next)
+		log.Printf("Reloaded configuration %s with NASes %v", file, next.Names())
+
+		if check != nil {
+			check(previous, next)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// UnlockKey is the source of the key unlocking a configuration's encrypted root dataset
+// when it is locked, e.g. after a reboot of the NAS. Exactly one of File, Env and Secret is set.
+type UnlockKey struct {
+	// File holds the passphrase, or the key if Hex is set
+	File string `yaml:"file,omitempty"`
+
+	// Env names an environment variable holding the passphrase, or the key if Hex is set
+	Env string `yaml:"env,omitempty"`
+
+	// Secret is the directory a secret is mounted at, holding either a passphrase or a key entry
+	Secret string `yaml:"secret,omitempty"`
+
+	// Hex means File or Env holds a key of 64 hexadecimal characters instead of a passphrase
+	Hex bool `yaml:"hex,omitempty"`
+}
+
+// Validate checks that exactly one source is specified
+func (k *UnlockKey) Validate() error {
+	sources := 0
+	for _, source := range []string{k.File, k.Env, k.Secret} {
+		if source != "" {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		return fmt.Errorf("Invalid unlockKey: exactly one of file, env and secret must be specified")
+	}
+	if k.Secret != "" && k.Hex {
+		return fmt.Errorf("Invalid unlockKey: hex cannot be used with secret")
+	}
+
+	return nil
+}
+
+// Read returns the key in the form of CSI secrets, with either a passphrase or a key entry.
+// The source is read on each call, so that changes are picked up.
+func (k *UnlockKey) Read() (map[string]string, error) {
+	if k.Secret != "" {
+		secrets := map[string]string{}
+		for _, name := range []string{"passphrase", "key"} {
+			value, err := os.ReadFile(path.Join(k.Secret, name))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = strings.TrimRight(string(value), "\r\n")
+		}
+
+		return secrets, nil
+	}
+
+	var value string
+	if k.File != "" {
+		content, err := os.ReadFile(k.File)
+		if err != nil {
+			return nil, err
+		}
+		value = strings.TrimRight(string(content), "\r\n")
+	} else {
+		var ok bool
+		if value, ok = os.LookupEnv(k.Env); !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", k.Env)
+		}
+	}
+
+	if k.Hex {
+		return map[string]string{"key": value}, nil
+	}
+
+	return map[string]string{"passphrase": value}, nil
+}
@@ -3,17 +3,21 @@ package controller
 import (
 	"context"
 	"crypto/sha1"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"path"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/tv42/zbase32"
 	"google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dravanet/truenas-csi/pkg/config"
 	"github.com/dravanet/truenas-csi/pkg/csi"
@@ -26,10 +30,20 @@ const (
 	xTruenasForceSqlFiltersHeaderValue = "true"
 
 	retainedKey = "RETAINED"
+
+	// volumeUsageThreshold is the percentage of refquota above which a volume is reported abnormal
+	volumeUsageThreshold = 95
 )
 
 type server struct {
-	config config.CSIConfiguration
+	// config is replaced on reload, each call uses the configuration loaded at its start
+	config *config.Store
+
+	// publishMu serializes share updates during publish/unpublish
+	publishMu sync.Mutex
+
+	// discoveryConfigured holds iscsi configurations whose portal discovery authentication is set up
+	discoveryConfigured sync.Map
 
 	csi.UnimplementedControllerServer
 }
@@ -49,53 +63,109 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 		return nil, status.Error(codes.InvalidArgument, "No VolumeCapabilities specified")
 	}
 
-	nasName := req.Parameters[config.NasSelector]
-	if nasName == "" {
-		nasName = "default"
+	// Preferred topologies are tried first
+	var topologies []*csi.Topology
+	if requirement := req.AccessibilityRequirements; requirement != nil {
+		topologies = append(topologies, requirement.Preferred...)
+		topologies = append(topologies, requirement.Requisite...)
 	}
-	nas := cs.config[nasName]
-	if nas == nil {
-		return nil, status.Errorf(codes.Unavailable, "No nas found with name %q", nasName)
+
+	nases := cs.config.Load()
+
+	nas, cfg, err := selectConfiguration(nases, req.Parameters, topologies)
+	if err != nil {
+		return nil, err
+	}
+
+	mod, err := parseMutableParameters(req.MutableParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := parseParameters(cfg, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	permission, err := datasetPermission(cfg, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	encryption, err := parseEncryption(req.Parameters, req.Secrets)
+	if err != nil {
+		return nil, err
 	}
 
 	cl, err := newTruenasOapiClient(nas)
 	if err != nil {
-		return nil, status.Errorf(codes.Unavailable, "creating TruenasOapi client failed for %q", nasName)
+		return nil, status.Errorf(codes.Unavailable, "creating TruenasOapi client failed for %q", nas.Name())
 	}
 
-	configName := req.Parameters[config.ConfigSelector]
-	if configName == "" {
-		configName = "default"
+	if err = cs.ensureRootDatasetUnlocked(ctx, cl, nas, cfg); err != nil {
+		return nil, err
 	}
-	cfg := nas.Configurations[configName]
-	if cfg == nil {
-		return nil, status.Errorf(codes.Unavailable, "No configuration found with name %q", configName)
+
+	// Lookup volume content source
+	var sourceSnapshot *snapshotInfo
+	var temporarySnapshot bool
+	switch {
+	case req.VolumeContentSource.GetSnapshot() != nil:
+		if sourceSnapshot, err = cs.getSourceSnapshot(ctx, cl, nases, nas, req.VolumeContentSource.GetSnapshot().SnapshotId); err != nil {
+			return nil, err
+		}
+	case req.VolumeContentSource.GetVolume() != nil:
+		// Volumes are cloned through a temporary snapshot
+		if sourceSnapshot, err = cs.snapshotSourceVolume(ctx, cl, nases, nas, req.VolumeContentSource.GetVolume().VolumeId, req.Name); err != nil {
+			return nil, err
+		}
+		temporarySnapshot = true
 	}
 
-	// According to req.VolumeCapabilities, filter out possible volume types
-	volume := true
-	filesystem := true
+	var sourceType string
+	if sourceSnapshot != nil {
+		// Volume type is determined by the snapshot's dataset
+		sourceDs, err := cs.getDataset(ctx, cl, sourceSnapshot.Dataset)
+		if err != nil {
+			return nil, err
+		}
+		if sourceDs == nil {
+			return nil, status.Errorf(codes.NotFound, "source dataset of %q not found", sourceSnapshot.ID)
+		}
 
-	for _, cap := range req.VolumeCapabilities {
-		switch {
-		case cap.GetBlock() != nil:
-			filesystem = false
-		case cap.GetMount() != nil:
-			switch cap.GetAccessMode().GetMode() {
-			case csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY, csi.VolumeCapability_AccessMode_MULTI_NODE_SINGLE_WRITER, csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER:
-				volume = false
-			}
+		if poolName(sourceSnapshot.Dataset) != poolName(cfg.Dataset) {
+			return nil, status.Errorf(codes.InvalidArgument, "content source %q resides in a different pool than %q", sourceSnapshot.ID, cfg.Dataset)
 		}
+
+		if encryption != nil && !sourceDs.Encrypted {
+			return nil, status.Errorf(codes.InvalidArgument, "content source %q is not encrypted, clones inherit encryption from their origin", sourceSnapshot.ID)
+		}
+
+		sourceType = sourceDs.Type
+	}
+
+	// According to req.VolumeCapabilities and the requested protocol, select the volume type
+	protocol, err := selectProtocol(cfg, req.Parameters[config.ProtocolSelector], req.VolumeCapabilities, sourceType)
+	if err != nil {
+		return nil, err
 	}
 
+	volume := protocol == config.ProtocolISCSI
+	filesystem := !volume
+	smb := protocol == config.ProtocolSMB
+
 	// Calculate capacity
 	capacityrange := req.CapacityRange
 	if capacityrange == nil {
-		// Default capacity of 1Gi
+		// Default capacity of 1Gi, or the size of the source snapshot
 		capacityrange = &csi.CapacityRange{
 			RequiredBytes: 1 << 30,
 			LimitBytes:    1 << 30,
 		}
+		if sourceSnapshot != nil && sourceSnapshot.SizeBytes > 0 {
+			capacityrange.RequiredBytes = sourceSnapshot.SizeBytes
+			capacityrange.LimitBytes = sourceSnapshot.SizeBytes
+		}
 	}
 
 	capacityBytes := capacityrange.LimitBytes
@@ -103,6 +173,10 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 		capacityBytes = capacityrange.RequiredBytes
 	}
 
+	if sourceSnapshot != nil && capacityBytes < sourceSnapshot.SizeBytes {
+		return nil, status.Errorf(codes.OutOfRange, "requested capacity %d is smaller than content source size %d", capacityBytes, sourceSnapshot.SizeBytes)
+	}
+
 	// Prepare create request
 	datasetName := datasetFromReqName(req.Name)
 	dataset := path.Join(cfg.Dataset, datasetName)
@@ -119,6 +193,11 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 			return nil, status.Errorf(codes.Unavailable, "cannot provision iscsi share for %q", req.Name)
 		}
 
+		// Nodes create a filesystem on fresh zvols
+		if props[config.PropertyReadonly] == "ON" && sourceSnapshot == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "readonly cannot be set on new iscsi volumes without a content source")
+		}
+
 		voltype := TruenasOapi.VOLUME
 		create.Type = &voltype
 
@@ -130,9 +209,14 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 			create.Volblocksize = &volblocksize
 		}
 
+		props.applyCreate(&create, true)
+
 	case filesystem:
 		// Create filesystem
-		if cfg.NFS == nil {
+		if smb && cfg.SMB == nil {
+			return nil, status.Errorf(codes.Unavailable, "cannot provision smb share for %q", req.Name)
+		}
+		if !smb && cfg.NFS == nil {
 			return nil, status.Errorf(codes.Unavailable, "cannot provision nfs share for %q", req.Name)
 		}
 
@@ -154,17 +238,37 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 		if refreservation > 0 && !cfg.Sparse {
 			create.Refreservation = &refreservation
 		}
+
+		props.applyCreate(&create, false)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Invalid VolumeCapabilities requested")
 	}
 
-	createresp, err := cl.PostPoolDataset(ctx, create)
+	if encryption != nil {
+		encryption.applyCreate(&create)
+	}
+
+	var createresp *http.Response
+	if sourceSnapshot != nil {
+		createresp, err = cl.PostZfsSnapshotClone(ctx, TruenasOapi.ZfsSnapshotClone0{
+			Snapshot:   &sourceSnapshot.ID,
+			DatasetDst: &dataset,
+		})
+	} else {
+		createresp, err = cl.PostPoolDataset(ctx, create)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "failed provisioning %q: %+v", req.Name, err)
 	}
 	_, _ = io.ReadAll(createresp.Body)
 	_ = createresp.Body.Close()
 
+	// cloned is set when a fresh clone needs its properties set
+	cloned := sourceSnapshot != nil && createresp.StatusCode == 200
+
+	// readonly is set when an existing dataset has been made read-only by a previous call
+	var readonly bool
+
 	if createresp.StatusCode != 200 {
 		// Create failed due to conflict or other errors
 		ds, err := cs.getDataset(ctx, cl, dataset)
@@ -176,46 +280,84 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 			return nil, status.Errorf(codes.Unavailable, "failed querying existing dataset %q: not found", dataset)
 		}
 
-		if ds.Comments != req.Name {
-			return nil, status.Errorf(codes.Unavailable, "dataset for %q exists with different comment, perhaps hash collision?", req.Name)
-		}
+		if sourceSnapshot != nil && ds.Comments == "" && ds.Origin == sourceSnapshot.ID {
+			// A previous clone attempt has been interrupted
+			cloned = true
+		} else {
+			if ds.Comments != req.Name {
+				return nil, status.Errorf(codes.Unavailable, "dataset for %q exists with different comment, perhaps hash collision?", req.Name)
+			}
 
-		var capacityChanged bool
-		switch {
-		case volume:
-			if ds.Volsize == nil {
-				return nil, errVolumecapabilititesChanged
+			var capacityChanged bool
+			switch {
+			case volume:
+				if ds.Volsize == nil {
+					return nil, errVolumecapabilititesChanged
+				}
+				capacityChanged = capacityBytes != *ds.Volsize
+			case filesystem:
+				if ds.Refquota == nil {
+					return nil, errVolumecapabilititesChanged
+				}
+				capacityChanged = capacityBytes != *ds.Refquota
 			}
-			capacityChanged = capacityBytes != *ds.Volsize
-		case filesystem:
-			if ds.Refquota == nil {
-				return nil, errVolumecapabilititesChanged
+
+			if capacityChanged {
+				return nil, status.Errorf(codes.AlreadyExists, "capacity requirements changed for existing volume %q", req.Name)
 			}
-			capacityChanged = capacityBytes != *ds.Refquota
 		}
 
-		if capacityChanged {
-			return nil, status.Errorf(codes.AlreadyExists, "capacity requirements changed for existing volume %q", req.Name)
+		// An existing volume may have been locked since, e.g. by a reboot of the NAS
+		if err = cs.unlockVolume(ctx, cl, ds, req.Secrets); err != nil {
+			return nil, err
+		}
+
+		readonly = ds.Readonly
+	}
+
+	if cloned {
+		// Clones inherit size and properties from their origin, apply requested capacity
+		// and properties, and annotate with comment
+		update := TruenasOapi.PoolDatasetUpdate1{
+			Comments:       create.Comments,
+			Volsize:        create.Volsize,
+			Refquota:       create.Refquota,
+			Refreservation: create.Refreservation,
+		}
+		props.applyUpdate(&update, volume)
+
+		if _, err = handleNasResponse(cl.PutPoolDatasetIdId(ctx, dataset, update)); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed updating cloned dataset for %q: %+v", req.Name, err)
+		}
+	}
+
+	if temporarySnapshot {
+		// The temporary snapshot is destroyed along with its last clone
+		if err = cs.deleteSnapshot(ctx, cl, sourceSnapshot.ID); err != nil {
+			return nil, err
 		}
 	}
 
 	// Dataset ready, set permissions on filesystem
-	if filesystem && !volume {
-		// Set permissions to world-writable
-		mode := "0777"
-		if _, err = handleNasResponse(cl.PostPoolDatasetIdIdPermission(ctx, dataset, TruenasOapi.PoolDatasetPermission1{
-			Acl:  &[]map[string]interface{}{},
-			Mode: &mode,
-		})); err != nil {
+	if filesystem && !volume && !readonly {
+		if _, err = handleNasResponse(cl.PostPoolDatasetIdIdPermission(ctx, dataset, *permission)); err != nil {
 			return nil, status.Errorf(codes.Unavailable, "failed setting permissions on dataset for %q", req.Name)
 		}
 	}
 
+	if update := props.applyReadonly(); update != nil {
+		if _, err = handleNasResponse(cl.PutPoolDatasetIdId(ctx, dataset, *update)); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed setting readonly on dataset for %q: %+v", req.Name, err)
+		}
+	}
+
 	var volumeContext *volumecontext.VolumeContext
 
 	switch {
 	case volume:
 		volumeContext, err = cs.createISCSIVolume(ctx, cl, cfg.ISCSI, req.Name, dataset, datasetName)
+	case smb:
+		volumeContext, err = cs.createSMBVolume(ctx, cl, cfg.SMB, req.Name, dataset, datasetName)
 	case filesystem:
 		volumeContext, err = cs.createNFSVolume(ctx, cl, cfg.NFS, req.Name, dataset)
 	}
@@ -224,6 +366,20 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 		return nil, err
 	}
 
+	if len(req.MutableParameters) > 0 {
+		di, err := cs.getDataset(ctx, cl, dataset)
+		if err != nil {
+			return nil, err
+		}
+		if di == nil {
+			return nil, status.Errorf(codes.Unavailable, "failed querying dataset %q: not found", dataset)
+		}
+
+		if err = cs.modifyVolume(ctx, cl, cfg.NFS, di, mod); err != nil {
+			return nil, err
+		}
+	}
+
 	serialized, _ := volumecontext.Base64Serializer().Serialize(volumeContext)
 
 	volumeid := fmt.Sprintf("%s:%s", nas.Name(), dataset)
@@ -235,6 +391,8 @@ func (cs *server) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest
 			VolumeContext: map[string]string{
 				"b64": serialized,
 			},
+			ContentSource:      req.VolumeContentSource,
+			AccessibleTopology: accessibleTopology(nas),
 		},
 	}, nil
 }
@@ -246,10 +404,21 @@ func (cs *server) DeleteVolume(ctx context.Context, req *csi.DeleteVolumeRequest
 
 	// from here req is not null
 
-	nas, dataset, err := cs.parsevolumeid(req.VolumeId)
-	if err != nil {
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
+	if status.Code(err) == codes.NotFound {
+		// Malformed ids do not refer to volumes
 		return &csi.DeleteVolumeResponse{}, nil
 	}
+	if err != nil {
+		// The NAS may have been removed by a reload, the volume is kept until it is configured again
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot delete volume %q: %+v", req.VolumeId, err)
+	}
+
+	if nas.GetConfigurationForRootDataset(path.Dir(dataset)) == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot delete volume %q: root dataset %q is not configured", req.VolumeId, path.Dir(dataset))
+	}
 
 	cl, err := newTruenasOapiClient(nas)
 	if err != nil {
@@ -265,13 +434,20 @@ func (cs *server) DeleteVolume(ctx context.Context, req *csi.DeleteVolumeRequest
 
 		switch di.Type {
 		case "FILESYSTEM":
-			err = cs.deleteNFSVolume(ctx, cl, di)
+			if err = cs.deleteNFSVolume(ctx, cl, di); err == nil {
+				err = cs.deleteSMBVolume(ctx, cl, di)
+			}
 		case "VOLUME":
 			err = cs.deleteISCSIVolume(ctx, cl, di)
 		default:
 			err = status.Errorf(codes.InvalidArgument, "Received invalid response from NAS: %+v", di)
 		}
 
+		if err == nil && dp == config.DeletePolicyDelete {
+			// Clones must not depend on the dataset being deleted
+			err = cs.promoteClones(ctx, cl, dataset)
+		}
+
 		if err == nil {
 			err = cs.removeDataset(ctx, cl, di, dp)
 		}
@@ -284,6 +460,135 @@ func (cs *server) DeleteVolume(ctx context.Context, req *csi.DeleteVolumeRequest
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
+// ControllerPublishVolume grants access to a volume for a node
+func (cs *server) ControllerPublishVolume(ctx context.Context, req *csi.ControllerPublishVolumeRequest) (*csi.ControllerPublishVolumeResponse, error) {
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No VolumeId specified")
+	}
+
+	// from here req is not null
+
+	if req.NodeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "No NodeId specified")
+	}
+
+	if req.VolumeCapability == nil {
+		return nil, status.Error(codes.InvalidArgument, "No VolumeCapability specified")
+	}
+
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	cfg := nas.GetConfigurationForRootDataset(path.Dir(dataset))
+	if cfg == nil {
+		return nil, status.Errorf(codes.NotFound, "Volume %q is not under a configured root dataset", req.VolumeId)
+	}
+
+	if err = cs.ensureRootDatasetUnlocked(ctx, cl, nas, cfg); err != nil {
+		return nil, err
+	}
+
+	di, err := cs.getDataset(ctx, cl, dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil {
+		return nil, status.Errorf(codes.NotFound, "Volume does not exist")
+	}
+
+	if err = cs.unlockVolume(ctx, cl, di, req.Secrets); err != nil {
+		return nil, err
+	}
+
+	smb, err := cs.isSMBVolume(ctx, cl, cfg, di)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.publishMu.Lock()
+	defer cs.publishMu.Unlock()
+
+	switch {
+	case smb:
+		// smb shares are not restricted to nodes
+	case di.Type == "FILESYSTEM":
+		err = cs.publishNFSVolume(ctx, cl, cfg.NFS, di, req.NodeId)
+	case di.Type == "VOLUME":
+		err = cs.publishISCSIVolume(ctx, cl, cfg.ISCSI, di, req.NodeId, req.VolumeCapability)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &csi.ControllerPublishVolumeResponse{}, nil
+}
+
+// ControllerUnpublishVolume revokes access to a volume from a node
+func (cs *server) ControllerUnpublishVolume(ctx context.Context, req *csi.ControllerUnpublishVolumeRequest) (*csi.ControllerUnpublishVolumeResponse, error) {
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No VolumeId specified")
+	}
+
+	// from here req is not null
+
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
+	if err != nil {
+		return &csi.ControllerUnpublishVolumeResponse{}, nil
+	}
+
+	cfg := nas.GetConfigurationForRootDataset(path.Dir(dataset))
+	if cfg == nil {
+		return &csi.ControllerUnpublishVolumeResponse{}, nil
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	di, err := cs.getDataset(ctx, cl, dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil {
+		return &csi.ControllerUnpublishVolumeResponse{}, nil
+	}
+
+	smb, err := cs.isSMBVolume(ctx, cl, cfg, di)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.publishMu.Lock()
+	defer cs.publishMu.Unlock()
+
+	switch {
+	case smb:
+	case di.Type == "FILESYSTEM":
+		err = cs.unpublishNFSVolume(ctx, cl, cfg.NFS, di, req.NodeId)
+	case di.Type == "VOLUME":
+		err = cs.unpublishISCSIVolume(ctx, cl, cfg.ISCSI, di, req.NodeId)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &csi.ControllerUnpublishVolumeResponse{}, nil
+}
+
 // ValidateVolumeCapabilities validates request.
 func (cs *server) ValidateVolumeCapabilities(ctx context.Context, req *csi.ValidateVolumeCapabilitiesRequest) (*csi.ValidateVolumeCapabilitiesResponse, error) {
 	if len(req.VolumeCapabilities) == 0 {
@@ -292,7 +597,9 @@ func (cs *server) ValidateVolumeCapabilities(ctx context.Context, req *csi.Valid
 
 	// from here req is not null
 
-	nas, dataset, err := cs.parsevolumeid(req.VolumeId)
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
 	if err != nil {
 		return nil, err
 	}
@@ -311,22 +618,38 @@ func (cs *server) ValidateVolumeCapabilities(ctx context.Context, req *csi.Valid
 		return nil, status.Errorf(codes.NotFound, "Volume does not exist")
 	}
 
-	for _, cap := range req.VolumeCapabilities {
-		switch {
-		case cap.GetBlock() != nil:
-			if di.Type != "VOLUME" {
-				return &csi.ValidateVolumeCapabilitiesResponse{}, nil
+	// Capabilities are validated with the rules of protocol selection
+	protocol := config.ProtocolISCSI
+	if di.Type == "FILESYSTEM" {
+		protocol = config.ProtocolNFS
+
+		if cfg := nas.GetConfigurationForRootDataset(path.Dir(dataset)); cfg != nil {
+			smb, err := cs.isSMBVolume(ctx, cl, cfg, di)
+			if err != nil {
+				return nil, err
 			}
-		case cap.GetMount() != nil:
-			switch cap.GetAccessMode().GetMode() {
-			case csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY, csi.VolumeCapability_AccessMode_MULTI_NODE_SINGLE_WRITER, csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER:
-				if di.Type == "VOLUME" { // multi-node access with ISCSI is not allowed
-					return &csi.ValidateVolumeCapabilitiesResponse{}, nil
-				}
+			if smb {
+				protocol = config.ProtocolSMB
 			}
 		}
 	}
 
+	switch requested := req.Parameters[config.ProtocolSelector]; requested {
+	case "", config.ProtocolAuto, protocol:
+	default:
+		return &csi.ValidateVolumeCapabilitiesResponse{
+			Message: fmt.Sprintf("Volume is shared over %s, not %s", protocol, requested),
+		}, nil
+	}
+
+	for _, cap := range req.VolumeCapabilities {
+		if !protocolSupports(protocol, cap) {
+			return &csi.ValidateVolumeCapabilitiesResponse{
+				Message: fmt.Sprintf("Protocol %q does not provide the requested VolumeCapabilities", protocol),
+			}, nil
+		}
+	}
+
 	return &csi.ValidateVolumeCapabilitiesResponse{Confirmed: &csi.ValidateVolumeCapabilitiesResponse_Confirmed{
 		VolumeContext:      req.VolumeContext,
 		VolumeCapabilities: req.VolumeCapabilities,
@@ -334,6 +657,328 @@ func (cs *server) ValidateVolumeCapabilities(ctx context.Context, req *csi.Valid
 	}}, nil
 }
 
+// ControllerGetVolume reports capacity and condition of a volume
+func (cs *server) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No VolumeId specified")
+	}
+
+	// from here req is not null
+
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	di, err := cs.getDataset(ctx, cl, dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil || strings.Contains(di.Comments, retainedKey) {
+		return nil, status.Errorf(codes.NotFound, "Volume does not exist")
+	}
+
+	cfg := nas.GetConfigurationForRootDataset(path.Dir(dataset))
+	if cfg == nil {
+		return nil, status.Errorf(codes.NotFound, "Volume %q is not under a configured root dataset", req.VolumeId)
+	}
+
+	entry, err := cs.listVolumesEntry(ctx, cl, nas, cfg, di)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect problems of the volume
+	var problems []string
+
+	smb, err := cs.isSMBVolume(ctx, cl, cfg, di)
+	if err != nil {
+		return nil, err
+	}
+
+	var problem string
+	switch {
+	case smb:
+		problem, err = cs.checkSMBVolume(ctx, cl, di)
+	case di.Type == "FILESYSTEM":
+		problem, err = cs.checkNFSVolume(ctx, cl, cfg.NFS, di)
+	case di.Type == "VOLUME":
+		problem, err = cs.checkISCSIVolume(ctx, cl, di)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if problem != "" {
+		problems = append(problems, problem)
+	}
+
+	if di.Locked {
+		problems = append(problems, "volume is locked")
+	}
+
+	if problem, err = cs.checkPool(ctx, cl, poolName(dataset)); err != nil {
+		return nil, err
+	}
+	if problem != "" {
+		problems = append(problems, problem)
+	}
+
+	if di.Type == "FILESYSTEM" && di.Refquota != nil && *di.Refquota > 0 && di.Referenced != nil {
+		if *di.Referenced*100 > *di.Refquota*volumeUsageThreshold {
+			problems = append(problems, fmt.Sprintf("volume is over %d%% of its quota", volumeUsageThreshold))
+		}
+	}
+
+	var used int64
+	if di.Used != nil {
+		used = *di.Used
+	}
+
+	condition := &csi.VolumeCondition{
+		Message: fmt.Sprintf("used %d of %d bytes", used, entry.Volume.CapacityBytes),
+	}
+	if len(problems) > 0 {
+		condition.Abnormal = true
+		condition.Message = strings.Join(problems, "; ") + "; " + condition.Message
+	}
+
+	return &csi.ControllerGetVolumeResponse{
+		Volume: entry.Volume,
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			VolumeCondition: condition,
+		},
+	}, nil
+}
+
+// listVolumesToken holds the position of a ListVolumes walk
+type listVolumesToken struct {
+	Nas    string `json:"nas"`
+	Config string `json:"config"`
+	Offset int    `json:"offset"`
+}
+
+func (t *listVolumesToken) encode() string {
+	buf, _ := json.Marshal(t)
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func decodeListVolumesToken(s string) (*listVolumesToken, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var t listVolumesToken
+	if err = json.Unmarshal(buf, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// ListVolumes lists volumes of all configurations on all NASes
+func (cs *server) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
+	nases := cs.config.Load()
+
+	var start *listVolumesToken
+	if req.GetStartingToken() != "" {
+		var err error
+		if start, err = decodeListVolumesToken(req.StartingToken); err != nil {
+			return nil, status.Errorf(codes.Aborted, "Invalid starting token: %q", req.StartingToken)
+		}
+
+		if nas := nases[start.Nas]; nas == nil || nas.Configurations[start.Config] == nil || start.Offset < 0 {
+			return nil, status.Errorf(codes.Aborted, "Invalid starting token: %q", req.StartingToken)
+		}
+	}
+
+	maxEntries := int(req.GetMaxEntries())
+	var entries []*csi.ListVolumesResponse_Entry
+
+	for _, nasName := range nases.Names() {
+		if start != nil && nasName < start.Nas {
+			continue
+		}
+
+		nas := nases[nasName]
+
+		cl, err := newTruenasOapiClient(nas)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "creating TruenasOapi client failed for %q", nasName)
+		}
+
+		for _, configName := range nas.ConfigurationNames() {
+			offset := 0
+			if start != nil {
+				if nasName == start.Nas && configName < start.Config {
+					continue
+				}
+				offset = start.Offset
+				start = nil
+			}
+
+			cfg := nas.Configurations[configName]
+
+			for {
+				limit := 0
+				if maxEntries > 0 {
+					limit = maxEntries - len(entries)
+				}
+
+				datasets, err := cs.listDatasets(ctx, cl, cfg.Dataset, offset, limit)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, di := range datasets {
+					offset++
+
+					// Skip volumes already deleted with retain policy
+					if strings.Contains(di.Comments, retainedKey) {
+						continue
+					}
+
+					entry, err := cs.listVolumesEntry(ctx, cl, nas, cfg, di)
+					if err != nil {
+						return nil, err
+					}
+
+					entries = append(entries, entry)
+
+					if maxEntries > 0 && len(entries) == maxEntries {
+						next := &listVolumesToken{Nas: nasName, Config: configName, Offset: offset}
+
+						return &csi.ListVolumesResponse{
+							Entries:   entries,
+							NextToken: next.encode(),
+						}, nil
+					}
+				}
+
+				if limit == 0 || len(datasets) < limit {
+					break
+				}
+			}
+		}
+	}
+
+	return &csi.ListVolumesResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (cs *server) listVolumesEntry(ctx context.Context, cl *TruenasOapi.Client, nas *config.FreeNAS, cfg *config.Configuration, di *datasetInfo) (*csi.ListVolumesResponse_Entry, error) {
+	volume := &csi.Volume{
+		VolumeId:           fmt.Sprintf("%s:%s", nas.Name(), di.ID),
+		AccessibleTopology: accessibleTopology(nas),
+	}
+
+	var volumeContext *volumecontext.VolumeContext
+	var err error
+
+	switch di.Type {
+	case "VOLUME":
+		if di.Volsize != nil {
+			volume.CapacityBytes = *di.Volsize
+		}
+		if cfg.ISCSI != nil {
+			_, targetName := path.Split(di.ID)
+			volumeContext, err = cs.getISCSIVolumeContext(ctx, cl, cfg.ISCSI, targetName)
+		}
+	case "FILESYSTEM":
+		if di.Refquota != nil {
+			volume.CapacityBytes = *di.Refquota
+		}
+
+		var smb bool
+		if smb, err = cs.isSMBVolume(ctx, cl, cfg, di); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case smb:
+			volumeContext = smbVolumeContext(cfg.SMB, path.Base(di.ID))
+		case cfg.NFS != nil:
+			volumeContext = nfsVolumeContext(cfg.NFS, di.ID)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Volume context of locked volumes is withheld, as they cannot be shared
+	if volumeContext != nil && !di.Locked {
+		serialized, _ := volumecontext.Base64Serializer().Serialize(volumeContext)
+		volume.VolumeContext = map[string]string{
+			"b64": serialized,
+		}
+	}
+
+	return &csi.ListVolumesResponse_Entry{Volume: volume}, nil
+}
+
+// GetCapacity reports free space of the root dataset selected by parameters
+func (cs *server) GetCapacity(ctx context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
+	var topologies []*csi.Topology
+	if req.GetAccessibleTopology() != nil {
+		topologies = append(topologies, req.AccessibleTopology)
+	}
+
+	nases := cs.config.Load()
+
+	nas, cfg, err := selectConfiguration(nases, req.GetParameters(), topologies)
+	if status.Code(err) == codes.ResourceExhausted {
+		// No NAS is accessible from the requested topology
+		return &csi.GetCapacityResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "creating TruenasOapi client failed for %q", nas.Name())
+	}
+
+	di, err := cs.getDataset(ctx, cl, cfg.Dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil {
+		return nil, status.Errorf(codes.Unavailable, "root dataset %q not found", cfg.Dataset)
+	}
+
+	// available already accounts for quotas and reservations of the root dataset and its parents
+	var available int64
+	if di.Available != nil {
+		available = *di.Available
+	}
+
+	resp := &csi.GetCapacityResponse{
+		AvailableCapacity: available,
+	}
+
+	if cfg.Sparse {
+		// Sparse volumes do not reserve space, only a quota limits their size
+		if di.Quota != nil && *di.Quota > 0 {
+			resp.MaximumVolumeSize = wrapperspb.Int64(*di.Quota)
+		}
+	} else {
+		resp.MaximumVolumeSize = wrapperspb.Int64(available)
+	}
+
+	return resp, nil
+}
+
 // Expand volume
 func (cs *server) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
 	if req.GetVolumeId() == "" {
@@ -342,7 +987,9 @@ func (cs *server) ControllerExpandVolume(ctx context.Context, req *csi.Controlle
 
 	// from here req is not null
 
-	nas, dataset, err := cs.parsevolumeid(req.VolumeId)
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
 	if err != nil {
 		return nil, err
 	}
@@ -387,7 +1034,8 @@ func (cs *server) ControllerExpandVolume(ctx context.Context, req *csi.Controlle
 		}
 
 		update.Refquota = &refquota
-		if !nas.GetSparseForRootDataset(path.Dir(dataset)) {
+		// Sparseness may have been modified, follow the dataset's current state
+		if di.Refreservation != nil && *di.Refreservation > 0 {
 			update.Refreservation = &refreservation
 		}
 
@@ -414,6 +1062,13 @@ func (cs *server) ControllerGetCapabilities(ctx context.Context, req *csi.Contro
 					},
 				},
 			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
+					},
+				},
+			},
 			{
 				Type: &csi.ControllerServiceCapability_Rpc{
 					Rpc: &csi.ControllerServiceCapability_RPC{
@@ -428,15 +1083,150 @@ func (cs *server) ControllerGetCapabilities(ctx context.Context, req *csi.Contro
 					},
 				},
 			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_GET_VOLUME,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+					},
+				},
+			},
+			{
+				Type: &csi.ControllerServiceCapability_Rpc{
+					Rpc: &csi.ControllerServiceCapability_RPC{
+						Type: csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
+					},
+				},
+			},
 		},
 	}, nil
 }
 
-// New returns a new csi.ControllerServer
-func New(cfg config.CSIConfiguration) csi.ControllerServer {
+// New returns a new csi.ControllerServer using the configuration held by store
+func New(store *config.Store) csi.ControllerServer {
 	return &server{
-		config: cfg,
+		config: store,
+	}
+}
+
+// selectConfiguration returns the NAS and its configuration selected by parameters.
+// If no NAS is specified, the first one accessible from topologies is selected.
+func selectConfiguration(nases config.CSIConfiguration, parameters map[string]string, topologies []*csi.Topology) (*config.FreeNAS, *config.Configuration, error) {
+	configName := parameters[config.ConfigSelector]
+	if configName == "" {
+		configName = "default"
+	}
+
+	var nas *config.FreeNAS
+
+	nasName := parameters[config.NasSelector]
+	switch {
+	case nasName == "" && len(topologies) > 0:
+		if nas = selectNasForTopologies(nases, configName, topologies); nas == nil {
+			return nil, nil, status.Errorf(codes.ResourceExhausted, "No nas with configuration %q is accessible from requested topology", configName)
+		}
+	case nasName == "":
+		nasName = "default"
+		fallthrough
+	default:
+		if nas = nases[nasName]; nas == nil {
+			return nil, nil, status.Errorf(codes.Unavailable, "No nas found with name %q", nasName)
+		}
+
+		if len(topologies) > 0 && !nasAccessibleFrom(nas, topologies) {
+			return nil, nil, status.Errorf(codes.ResourceExhausted, "nas %q is not accessible from requested topology", nasName)
+		}
+	}
+
+	cfg := nas.Configurations[configName]
+	if cfg == nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "No configuration found with name %q", configName)
 	}
+
+	return nas, cfg, nil
+}
+
+// selectNasForTopologies returns the first NAS having configuration configName accessible from
+// topologies, in order of topologies. The default NAS is preferred.
+func selectNasForTopologies(nases config.CSIConfiguration, configName string, topologies []*csi.Topology) *config.FreeNAS {
+	names := append([]string{"default"}, nases.Names()...)
+
+	for _, topology := range topologies {
+		for _, name := range names {
+			nas := nases[name]
+			if nas == nil || nas.Configurations[configName] == nil {
+				continue
+			}
+
+			if nas.AccessibleFrom(topology.GetSegments()) {
+				return nas
+			}
+		}
+	}
+
+	return nil
+}
+
+func nasAccessibleFrom(nas *config.FreeNAS, topologies []*csi.Topology) bool {
+	for _, topology := range topologies {
+		if nas.AccessibleFrom(topology.GetSegments()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// accessibleTopology returns topology segments NAS is accessible from, nil if accessible from everywhere
+func accessibleTopology(nas *config.FreeNAS) []*csi.Topology {
+	var topologies []*csi.Topology
+
+	for _, segments := range nas.Topology {
+		topologies = append(topologies, &csi.Topology{Segments: segments})
+	}
+
+	return topologies
 }
 
 func newTruenasOapiClient(cfg *config.FreeNAS) (*TruenasOapi.Client, error) {
@@ -478,11 +1268,20 @@ func truenasOapiFilter(key, value string) func(ctx context.Context, req *http.Re
 }
 
 type datasetInfo struct {
-	ID       string
-	Type     string
-	Comments string
-	Refquota *int64
-	Volsize  *int64
+	ID             string
+	Type           string
+	Comments       string
+	Origin         string
+	Refquota       *int64
+	Volsize        *int64
+	Available      *int64
+	Quota          *int64
+	Used           *int64
+	Referenced     *int64
+	Refreservation *int64
+	Encrypted      bool
+	Locked         bool
+	Readonly       bool
 }
 
 func (cs *server) getDataset(ctx context.Context, cl *TruenasOapi.Client, dataset string) (*datasetInfo, error) {
@@ -499,38 +1298,12 @@ func (cs *server) getDataset(ctx context.Context, cl *TruenasOapi.Client, datase
 
 	switch resp.StatusCode {
 	case 200:
-		var result struct {
-			ID       string `json:"id"`
-			Type     string `json:"type"`
-			Comments *struct {
-				Rawvalue string `json:"rawvalue"`
-			} `json:"comments"`
-			Volsize *struct {
-				Parsed int64 `json:"parsed"`
-			} `json:"volsize"`
-			Refquota *struct {
-				Parsed int64 `json:"parsed"`
-			} `json:"refquota"`
-		}
+		var result zfsDataset
 		if err = json.Unmarshal(body, &result); err != nil {
 			return nil, status.Errorf(codes.Unavailable, "Error parsing dataset from NAS: %+v", err)
 		}
 
-		di := &datasetInfo{
-			ID:   result.ID,
-			Type: result.Type,
-		}
-		if result.Comments != nil {
-			di.Comments = result.Comments.Rawvalue
-		}
-		if result.Volsize != nil {
-			di.Volsize = &result.Volsize.Parsed
-		}
-		if result.Refquota != nil {
-			di.Refquota = &result.Refquota.Parsed
-		}
-
-		return di, nil
+		return result.toDatasetInfo(), nil
 	case 404:
 		return nil, nil
 	}
@@ -538,6 +1311,114 @@ func (cs *server) getDataset(ctx context.Context, cl *TruenasOapi.Client, datase
 	return nil, status.Errorf(codes.Unavailable, "Unexpected result from Nas: %s", string(body))
 }
 
+// listDatasets returns at most limit child datasets of rootds starting at offset, ordered by their id.
+// limit of 0 means no limit.
+func (cs *server) listDatasets(ctx context.Context, cl *TruenasOapi.Client, rootds string, offset, limit int) ([]*datasetInfo, error) {
+	sortBy := "id"
+	params := &TruenasOapi.GetPoolDatasetParams{
+		Sort:   &sortBy,
+		Offset: &offset,
+	}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+
+	body, err := handleNasResponse(cl.GetPoolDataset(ctx, params, truenasOapiFilter("id__regex", fmt.Sprintf("^%s/[^/]+$", regexp.QuoteMeta(rootds)))))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []zfsDataset
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error parsing datasets from NAS: %+v", err)
+	}
+
+	datasets := make([]*datasetInfo, 0, len(result))
+	for i := range result {
+		datasets = append(datasets, result[i].toDatasetInfo())
+	}
+
+	return datasets, nil
+}
+
+type zfsDataset struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Comments *struct {
+		Rawvalue string `json:"rawvalue"`
+	} `json:"comments"`
+	Origin *struct {
+		Rawvalue string `json:"rawvalue"`
+	} `json:"origin"`
+	Volsize *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"volsize"`
+	Refquota *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"refquota"`
+	Available *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"available"`
+	Quota *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"quota"`
+	Used *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"used"`
+	Referenced *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"referenced"`
+	Refreservation *struct {
+		Parsed int64 `json:"parsed"`
+	} `json:"refreservation"`
+	Readonly *struct {
+		Value string `json:"value"`
+	} `json:"readonly"`
+	Encrypted bool `json:"encrypted"`
+	Locked    bool `json:"locked"`
+}
+
+func (result *zfsDataset) toDatasetInfo() *datasetInfo {
+	di := &datasetInfo{
+		ID:        result.ID,
+		Type:      result.Type,
+		Encrypted: result.Encrypted,
+		Locked:    result.Locked,
+	}
+	if result.Comments != nil {
+		di.Comments = result.Comments.Rawvalue
+	}
+	if result.Origin != nil {
+		di.Origin = result.Origin.Rawvalue
+	}
+	if result.Volsize != nil {
+		di.Volsize = &result.Volsize.Parsed
+	}
+	if result.Refquota != nil {
+		di.Refquota = &result.Refquota.Parsed
+	}
+	if result.Available != nil {
+		di.Available = &result.Available.Parsed
+	}
+	if result.Quota != nil {
+		di.Quota = &result.Quota.Parsed
+	}
+	if result.Used != nil {
+		di.Used = &result.Used.Parsed
+	}
+	if result.Referenced != nil {
+		di.Referenced = &result.Referenced.Parsed
+	}
+	if result.Refreservation != nil {
+		di.Refreservation = &result.Refreservation.Parsed
+	}
+	if result.Readonly != nil {
+		di.Readonly = result.Readonly.Value == "ON"
+	}
+
+	return di
+}
+
 // removeDataset removes or annotates a given dataset
 func (cs *server) removeDataset(ctx context.Context, cl *TruenasOapi.Client, dataset *datasetInfo, dp config.DeletePolicy) error {
 	var err error
@@ -570,6 +1451,37 @@ func (cs *server) removeDataset(ctx context.Context, cl *TruenasOapi.Client, dat
 	return err
 }
 
+// checkPool returns a description of the pool's problem, or "" if it is healthy
+func (cs *server) checkPool(ctx context.Context, cl *TruenasOapi.Client, pool string) (string, error) {
+	body, err := handleNasResponse(cl.GetPool(ctx, &TruenasOapi.GetPoolParams{}, truenasOapiFilter("name", pool)))
+	if err != nil {
+		return "", err
+	}
+
+	var pools []struct {
+		Name    string `json:"name"`
+		Status  string `json:"status"`
+		Healthy bool   `json:"healthy"`
+	}
+	if err = json.Unmarshal(body, &pools); err != nil {
+		return "", status.Errorf(codes.Unavailable, "Error parsing pools from NAS: %+v", err)
+	}
+
+	for _, p := range pools {
+		if p.Name != pool {
+			continue
+		}
+
+		if p.Status != "ONLINE" || !p.Healthy {
+			return fmt.Sprintf("pool %q is %s", pool, p.Status), nil
+		}
+
+		return "", nil
+	}
+
+	return fmt.Sprintf("pool %q not found", pool), nil
+}
+
 func (cs *server) getTruenasProductType(ctx context.Context, cl *TruenasOapi.Client) (product_type string, err error) {
 	body, err := handleNasResponse(cl.GetSystemProductType(ctx))
 	if err != nil {
@@ -629,7 +1541,7 @@ func extractID(data []byte) (int, error) {
 	return *result.ID, nil
 }
 
-func (cs *server) parsevolumeid(volumeid string) (nas *config.FreeNAS, dataset string, err error) {
+func parsevolumeid(nases config.CSIConfiguration, volumeid string) (nas *config.FreeNAS, dataset string, err error) {
 	if volumeid == "" {
 		err = status.Errorf(codes.InvalidArgument, "VolumeId not provided")
 		return
@@ -642,7 +1554,7 @@ func (cs *server) parsevolumeid(volumeid string) (nas *config.FreeNAS, dataset s
 		return
 	}
 
-	nas = cs.config[parts[0]]
+	nas = nases[parts[0]]
 
 	if nas == nil {
 		err = status.Errorf(codes.Unavailable, "NAS %s not found", parts[0])
@@ -659,3 +1571,8 @@ func datasetFromReqName(reqName string) string {
 
 	return zbase32.EncodeToString(hashed[:])
 }
+
+// poolName returns the pool name of a dataset
+func poolName(dataset string) string {
+	return strings.SplitN(dataset, "/", 2)[0]
+}
@@ -0,0 +1,21 @@
+package controller
+
+import "testing"
+
+func TestListVolumesToken(t *testing.T) {
+	token := &listVolumesToken{Nas: "nas1", Config: "default", Offset: 42}
+
+	got, err := decodeListVolumesToken(token.encode())
+	if err != nil {
+		t.Fatalf("decodeListVolumesToken() error = %v", err)
+	}
+	if *got != *token {
+		t.Errorf("decodeListVolumesToken() = %+v, want %+v", *got, *token)
+	}
+
+	for _, s := range []string{"!", "bm90IGpzb24"} {
+		if _, err := decodeListVolumesToken(s); err == nil {
+			t.Errorf("decodeListVolumesToken(%q) succeeded, want error", s)
+		}
+	}
+}
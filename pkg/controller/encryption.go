@@ -0,0 +1,363 @@
+package controller
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+)
+
+// Secret keys holding key material of encrypted volumes, one of them must be set
+const (
+	encryptionPassphraseSecret = "passphrase"
+	encryptionKeySecret        = "key"
+)
+
+// encryptionKey holds key material of an encrypted volume, either a passphrase or a hex key
+type encryptionKey struct {
+	passphrase *string
+	key        *string
+}
+
+// parseEncryptionKey returns key material from secrets, or nil if secrets have none
+func parseEncryptionKey(secrets map[string]string) (*encryptionKey, error) {
+	passphrase, hasPassphrase := secrets[encryptionPassphraseSecret]
+	key, hasKey := secrets[encryptionKeySecret]
+
+	switch {
+	case hasPassphrase && hasKey:
+		return nil, status.Errorf(codes.InvalidArgument, "Secrets must hold either %q or %q", encryptionPassphraseSecret, encryptionKeySecret)
+	case hasPassphrase:
+		if len(passphrase) < 8 {
+			return nil, status.Errorf(codes.InvalidArgument, "Encryption passphrase must be at least 8 characters")
+		}
+
+		return &encryptionKey{passphrase: &passphrase}, nil
+	case hasKey:
+		if b, err := hex.DecodeString(key); err != nil || len(b) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Encryption key must be 64 hexadecimal characters")
+		}
+
+		return &encryptionKey{key: &key}, nil
+	}
+
+	return nil, nil
+}
+
+// datasetEncryptionOptions is the type of PoolDatasetCreate0.EncryptionOptions
+type datasetEncryptionOptions = struct {
+	Algorithm   *TruenasOapi.PoolDatasetCreate0EncryptionOptionsAlgorithm `json:"algorithm,omitempty"`
+	GenerateKey *bool                                                     `json:"generate_key,omitempty"`
+	Key         *string                                                   `json:"key"`
+	Passphrase  *string                                                   `json:"passphrase"`
+	Pbkdf2iters *int                                                      `json:"pbkdf2iters,omitempty"`
+}
+
+// parseEncryption returns key material for a new volume if encryption is requested in parameters
+func parseEncryption(parameters map[string]string, secrets map[string]string) (*encryptionKey, error) {
+	value, ok := parameters[config.EncryptionParameter]
+	if !ok {
+		return nil, nil
+	}
+
+	encrypted, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid value for %s: %q", config.EncryptionParameter, value)
+	}
+	if !encrypted {
+		return nil, nil
+	}
+
+	ek, err := parseEncryptionKey(secrets)
+	if err != nil {
+		return nil, err
+	}
+	if ek == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Encryption requested, but provisioner secrets hold neither %q nor %q", encryptionPassphraseSecret, encryptionKeySecret)
+	}
+
+	return ek, nil
+}
+
+// applyCreate requests an encrypted dataset with the key on a create request
+func (ek *encryptionKey) applyCreate(create *TruenasOapi.PoolDatasetCreate0) {
+	encryption := true
+	inherit := false
+
+	create.Encryption = &encryption
+	create.InheritEncryption = &inherit
+	create.EncryptionOptions = &datasetEncryptionOptions{
+		Passphrase: ek.passphrase,
+		Key:        ek.key,
+	}
+}
+
+// unlockVolume unlocks a locked volume with key material from secrets
+func (cs *server) unlockVolume(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo, secrets map[string]string) error {
+	if !di.Locked {
+		return nil
+	}
+
+	ek, err := parseEncryptionKey(secrets)
+	if err != nil {
+		return err
+	}
+	if ek == nil {
+		return status.Errorf(codes.FailedPrecondition, "Volume %q is locked, and no key is provided in secrets", di.ID)
+	}
+
+	if err = cs.unlockDataset(ctx, cl, di.ID, ek); err != nil {
+		return err
+	}
+
+	di.Locked = false
+
+	return nil
+}
+
+// unlockDataset unlocks an encryption root with a key
+func (cs *server) unlockDataset(ctx context.Context, cl *TruenasOapi.Client, id string, ek *encryptionKey) error {
+	dataset := map[string]interface{}{"name": id}
+	if ek.passphrase != nil {
+		dataset["passphrase"] = *ek.passphrase
+	} else {
+		dataset["key"] = *ek.key
+	}
+
+	job, err := handleNasResponse(cl.PostPoolDatasetUnlock(ctx, TruenasOapi.PoolDatasetUnlock{
+		Id: &id,
+		UnlockOptions: &TruenasOapi.PoolDatasetUnlock1{
+			Datasets: &[]map[string]interface{}{dataset},
+		},
+	}))
+	if err != nil {
+		return err
+	}
+
+	result, err := cs.waitForJob(ctx, cl, job)
+	if err != nil {
+		return err
+	}
+
+	var unlock struct {
+		Unlocked []string `json:"unlocked"`
+	}
+	if err = json.Unmarshal(result, &unlock); err != nil {
+		return status.Errorf(codes.Unavailable, "Error parsing unlock result from NAS: %+v", err)
+	}
+	if !slices.Contains(unlock.Unlocked, id) {
+		return status.Errorf(codes.FailedPrecondition, "Failed unlocking %q: %s", id, string(result))
+	}
+
+	return nil
+}
+
+// rootUnlockMu serializes unlocking of root datasets, by calls and by UnlockRootDatasets
+var rootUnlockMu sync.Mutex
+
+// unlockTimeout limits the time spent unlocking root datasets of a configuration
+const unlockTimeout = time.Minute
+
+// UnlockRootDatasets unlocks locked root datasets of all configurations having an unlockKey,
+// e.g. at startup, after a reload, and periodically, so that volumes attached while the NAS
+// rebooted recover. Failures are logged.
+func UnlockRootDatasets(nases config.CSIConfiguration) {
+	ctx, cancel := context.WithTimeout(context.Background(), unlockTimeout)
+	defer cancel()
+
+	cs := &server{}
+
+	for _, nasName := range nases.Names() {
+		nas := nases[nasName]
+
+		for _, configName := range nas.ConfigurationNames() {
+			cfg := nas.Configurations[configName]
+			if cfg.UnlockKey == nil {
+				continue
+			}
+
+			cl, err := newTruenasOapiClient(nas)
+			if err != nil {
+				log.Printf("Failed unlocking root dataset %q on %q: %+v", cfg.Dataset, nasName, err)
+				continue
+			}
+
+			if err = cs.ensureRootDatasetUnlocked(ctx, cl, nas, cfg); err != nil {
+				log.Printf("Failed unlocking root dataset %q on %q: %+v", cfg.Dataset, nasName, err)
+			}
+		}
+	}
+}
+
+// ensureRootDatasetUnlocked unlocks the encrypted root dataset of a configuration, or its
+// encrypted parents, with the configured unlock key when they are locked
+func (cs *server) ensureRootDatasetUnlocked(ctx context.Context, cl *TruenasOapi.Client, nas *config.FreeNAS, cfg *config.Configuration) error {
+	di, err := cs.getDataset(ctx, cl, cfg.Dataset)
+	if err != nil || di == nil || !di.Locked {
+		return err
+	}
+
+	rootUnlockMu.Lock()
+	defer rootUnlockMu.Unlock()
+
+	// Summary lists encryption roots with their state, in the whole pool, as the
+	// dataset may inherit encryption from a parent
+	pool := poolName(cfg.Dataset)
+	job, err := handleNasResponse(cl.PostPoolDatasetEncryptionSummary(ctx, TruenasOapi.PoolDatasetEncryptionSummary{
+		Id: &pool,
+	}))
+	if err != nil {
+		return err
+	}
+
+	result, err := cs.waitForJob(ctx, cl, job)
+	if err != nil {
+		return err
+	}
+
+	var summary []struct {
+		Name   string `json:"name"`
+		Locked bool   `json:"locked"`
+	}
+	if err = json.Unmarshal(result, &summary); err != nil {
+		return status.Errorf(codes.Unavailable, "Error parsing encryption summary from NAS: %+v", err)
+	}
+
+	// Locked encryption roots the dataset resides in, parents first
+	var roots []string
+	for _, root := range summary {
+		if root.Locked && (root.Name == cfg.Dataset || strings.HasPrefix(cfg.Dataset, root.Name+"/")) {
+			roots = append(roots, root.Name)
+		}
+	}
+	if len(roots) == 0 {
+		// Unlocked by another call meanwhile
+		return nil
+	}
+	sort.Slice(roots, func(i, j int) bool { return len(roots[i]) < len(roots[j]) })
+
+	if cfg.UnlockKey == nil {
+		return status.Errorf(codes.FailedPrecondition, "Dataset %q on %q is locked, and no unlockKey is configured", roots[0], nas.Name())
+	}
+
+	secrets, err := cfg.UnlockKey.Read()
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "Error reading unlockKey of %q on %q: %+v", cfg.Dataset, nas.Name(), err)
+	}
+
+	ek, err := parseEncryptionKey(secrets)
+	if err != nil {
+		return err
+	}
+	if ek == nil {
+		return status.Errorf(codes.FailedPrecondition, "unlockKey of %q on %q holds neither a passphrase nor a key", cfg.Dataset, nas.Name())
+	}
+
+	for _, root := range roots {
+		if err = cs.unlockDataset(ctx, cl, root, ek); err != nil {
+			return err
+		}
+
+		log.Printf("Unlocked encrypted dataset %q on %q", root, nas.Name())
+	}
+
+	return nil
+}
+
+// changeVolumeKey replaces the key of an encrypted volume with key material from secrets
+func (cs *server) changeVolumeKey(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo, secrets map[string]string) error {
+	if !di.Encrypted {
+		return status.Errorf(codes.InvalidArgument, "Volume %q is not encrypted", di.ID)
+	}
+
+	if err := cs.unlockVolume(ctx, cl, di, secrets); err != nil {
+		return err
+	}
+
+	ek, err := parseEncryptionKey(secrets)
+	if err != nil {
+		return err
+	}
+	if ek == nil {
+		return status.Errorf(codes.InvalidArgument, "No key is provided in secrets")
+	}
+
+	job, err := handleNasResponse(cl.PostPoolDatasetChangeKey(ctx, TruenasOapi.PoolDatasetChangeKey{
+		Id: &di.ID,
+		ChangeKeyOptions: &TruenasOapi.PoolDatasetChangeKey1{
+			Passphrase: ek.passphrase,
+			Key:        ek.key,
+		},
+	}))
+	if err != nil {
+		return err
+	}
+
+	_, err = cs.waitForJob(ctx, cl, job)
+
+	return err
+}
+
+// jobPollInterval is the interval job states are polled with
+const jobPollInterval = 500 * time.Millisecond
+
+// waitForJob waits for a job to finish, returning its result. body is the response of the call starting the job.
+func (cs *server) waitForJob(ctx context.Context, cl *TruenasOapi.Client, body []byte) (json.RawMessage, error) {
+	id, err := strconv.Atoi(string(body))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Unexpected job id from NAS: %s", string(body))
+	}
+
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		jobresp, err := handleNasResponse(cl.GetCoreGetJobs(ctx, &TruenasOapi.GetCoreGetJobsParams{}, truenasOapiFilter("id", strconv.Itoa(id))))
+		if err != nil {
+			return nil, err
+		}
+
+		var jobs []struct {
+			ID     int             `json:"id"`
+			State  string          `json:"state"`
+			Error  *string         `json:"error"`
+			Result json.RawMessage `json:"result"`
+		}
+		if err = json.Unmarshal(jobresp, &jobs); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+		}
+		if len(jobs) != 1 || jobs[0].ID != id {
+			return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %s", string(jobresp))
+		}
+
+		switch job := jobs[0]; job.State {
+		case "SUCCESS":
+			return job.Result, nil
+		case "FAILED", "ABORTED":
+			message := job.State
+			if job.Error != nil {
+				message = *job.Error
+			}
+
+			return nil, status.Errorf(codes.Unavailable, "Job %d failed: %s", id, message)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, status.Errorf(codes.DeadlineExceeded, "Waiting for job %d: %+v", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+)
+
+type groupServer struct {
+	*server
+
+	csi.UnimplementedGroupControllerServer
+}
+
+// NewGroupController returns a new csi.GroupControllerServer using the configuration held by store
+func NewGroupController(store *config.Store) csi.GroupControllerServer {
+	return &groupServer{
+		server: &server{
+			config: store,
+		},
+	}
+}
+
+func (gs *groupServer) GroupControllerGetCapabilities(ctx context.Context, req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (gs *groupServer) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No name specified")
+	}
+
+	// from here req is not null
+
+	if len(req.SourceVolumeIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No SourceVolumeIds specified")
+	}
+
+	// Members must reside on the same NAS
+	nases := gs.config.Load()
+	var nas *config.FreeNAS
+	members := make([]string, 0, len(req.SourceVolumeIds))
+	for _, volumeid := range req.SourceVolumeIds {
+		volNas, dataset, err := parsevolumeid(nases, volumeid)
+		if err != nil {
+			return nil, err
+		}
+
+		if nas == nil {
+			nas = volNas
+		} else if volNas != nas {
+			return nil, status.Errorf(codes.InvalidArgument, "Volume %q resides on a different NAS", volumeid)
+		}
+
+		members = append(members, dataset)
+	}
+	sort.Strings(members)
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	datasets := make(map[string]*datasetInfo, len(members))
+	for _, dataset := range members {
+		di, err := gs.getDataset(ctx, cl, dataset)
+		if err != nil {
+			return nil, err
+		}
+		if di == nil {
+			return nil, status.Errorf(codes.NotFound, "Volume %q does not exist", fmt.Sprintf("%s:%s", nas.Name(), dataset))
+		}
+
+		datasets[dataset] = di
+	}
+
+	snapshotName := datasetFromReqName(req.Name)
+	group := strings.Join(members, ",")
+
+	// Lookup existing snapshots with the same name
+	existing, err := gs.listSnapshots(ctx, cl, truenasOapiFilter("snapshot_name", snapshotName))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotted := make(map[string]bool, len(existing))
+	for _, s := range existing {
+		if s.Name != req.Name || strings.Join(s.Group, ",") != group {
+			return nil, status.Errorf(codes.AlreadyExists, "group snapshot %q already exists for different sources", req.Name)
+		}
+
+		snapshotted[s.Source] = true
+	}
+
+	properties := map[string]interface{}{
+		snapshotNameProperty:  req.Name,
+		snapshotGroupProperty: group,
+	}
+	for _, dataset := range members {
+		var size int64
+		switch di := datasets[dataset]; {
+		case di.Volsize != nil:
+			size = *di.Volsize
+		case di.Refquota != nil:
+			size = *di.Refquota
+		}
+
+		properties[snapshotSizeProperty+":"+path.Base(dataset)] = strconv.FormatInt(size, 10)
+	}
+
+	// The TrueNAS API snapshots a single dataset, or recursively all of its children,
+	// so members are snapshotted one by one, not to touch other volumes
+	for _, dataset := range members {
+		if snapshotted[dataset] {
+			continue
+		}
+
+		if _, err = handleNasResponse(cl.PostZfsSnapshot(ctx, TruenasOapi.ZfsSnapshotCreate0{
+			Dataset:    &dataset,
+			Name:       &snapshotName,
+			Properties: &properties,
+		})); err != nil {
+			return nil, err
+		}
+	}
+
+	snaps, err := gs.getGroupSnapshots(ctx, cl, snapshotName, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(snaps) != len(members) {
+		return nil, status.Errorf(codes.Unavailable, "group snapshot for %q not complete after creation", req.Name)
+	}
+
+	return &csi.CreateVolumeGroupSnapshotResponse{
+		GroupSnapshot: groupSnapshotToCSI(nas, snaps),
+	}, nil
+}
+
+func (gs *groupServer) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	if req.GetGroupSnapshotId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No GroupSnapshotId specified")
+	}
+
+	// from here req is not null
+
+	nases := gs.config.Load()
+
+	nas, snapshotName, err := parsegroupsnapshotid(nases, req.GroupSnapshotId)
+	if status.Code(err) == codes.NotFound {
+		return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot delete group snapshot %q: %+v", req.GroupSnapshotId, err)
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	// Members deleted by an earlier call are gone, so SnapshotIds are not checked
+	snaps, err := gs.getGroupSnapshots(ctx, cl, snapshotName, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snap := range snaps {
+		if err = gs.deleteSnapshot(ctx, cl, snap.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+func (gs *groupServer) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	if req.GetGroupSnapshotId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No GroupSnapshotId specified")
+	}
+
+	// from here req is not null
+
+	nases := gs.config.Load()
+
+	nas, snapshotName, err := parsegroupsnapshotid(nases, req.GroupSnapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	snaps, err := gs.getGroupSnapshots(ctx, cl, snapshotName, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(snaps) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Group snapshot %q not found", req.GroupSnapshotId)
+	}
+
+	if err = checkGroupSnapshotIds(nas, snaps, req.SnapshotIds); err != nil {
+		return nil, err
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{
+		GroupSnapshot: groupSnapshotToCSI(nas, snaps),
+	}, nil
+}
+
+// getGroupSnapshots returns the member snapshots of a group snapshot. With cleanup, snapshots
+// of non-member datasets taken by recursive group snapshots of earlier versions are removed.
+func (gs *groupServer) getGroupSnapshots(ctx context.Context, cl *TruenasOapi.Client, snapshotName string, cleanup bool) ([]*snapshotInfo, error) {
+	snaps, err := gs.listSnapshots(ctx, cl, truenasOapiFilter("snapshot_name", snapshotName))
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*snapshotInfo, 0, len(snaps))
+	for _, snap := range snaps {
+		if len(snap.Group) == 0 || snap.DeferDestroy {
+			continue
+		}
+
+		switch {
+		case slices.Contains(snap.Group, snap.Source):
+			members = append(members, snap)
+		case cleanup:
+			if err = gs.deleteSnapshot(ctx, cl, snap.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return members, nil
+}
+
+func groupSnapshotToCSI(nas *config.FreeNAS, snaps []*snapshotInfo) *csi.VolumeGroupSnapshot {
+	group := &csi.VolumeGroupSnapshot{
+		ReadyToUse: true,
+	}
+
+	var creationTime int64
+	for _, snap := range snaps {
+		s := snap.toCSI(nas)
+
+		group.GroupSnapshotId = s.GroupSnapshotId
+		group.Snapshots = append(group.Snapshots, s)
+
+		if creationTime == 0 || snap.CreationTime < creationTime {
+			creationTime = snap.CreationTime
+		}
+	}
+	group.CreationTime = timestamppb.New(time.Unix(creationTime, 0))
+
+	return group
+}
+
+// checkGroupSnapshotIds verifies that snapshotIds, if specified, are members of the group snapshot
+func checkGroupSnapshotIds(nas *config.FreeNAS, snaps []*snapshotInfo, snapshotIds []string) error {
+	ids := make(map[string]bool, len(snaps))
+	for _, snap := range snaps {
+		ids[snap.toCSI(nas).SnapshotId] = true
+	}
+
+	for _, id := range snapshotIds {
+		if !ids[id] {
+			return status.Errorf(codes.InvalidArgument, "Snapshot %q is not part of the group snapshot", id)
+		}
+	}
+
+	return nil
+}
+
+func parsegroupsnapshotid(nases config.CSIConfiguration, groupsnapshotid string) (nas *config.FreeNAS, snapshotName string, err error) {
+	if nas, snapshotName, err = parsevolumeid(nases, groupsnapshotid); err != nil {
+		return
+	}
+
+	if snapshotName == "" || strings.ContainsAny(snapshotName, "/@") {
+		err = status.Errorf(codes.NotFound, "Invalid GroupSnapshotId received: %s", groupsnapshotid)
+	}
+
+	return
+}
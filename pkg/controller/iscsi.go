@@ -8,14 +8,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"path"
+	"slices"
 	"strings"
 
 	"google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 
 	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+	"github.com/dravanet/truenas-csi/pkg/nodeid"
 	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
 	"github.com/dravanet/truenas-csi/pkg/volumecontext"
 )
@@ -26,8 +28,7 @@ func (cs *server) createISCSIVolume(ctx context.Context, cl *TruenasOapi.Client,
 
 	var targetID int
 	var extentID int
-	var iscsiUsername string
-	var iscsiSecret string
+	var auth *iscsiAuth
 
 	// Create ISCSI Extent
 	extenttype := TruenasOapi.IscsiExtentCreate0TypeDISK
@@ -76,137 +77,327 @@ func (cs *server) createISCSIVolume(ctx context.Context, cl *TruenasOapi.Client,
 		extentID = extent.ID
 	}
 
-	// Create iSCSI
+	if iscsi.DiscoveryAuth != nil {
+		if err = cs.ensureISCSIDiscoveryAuth(ctx, cl, iscsi); err != nil {
+			return
+		}
+	}
+
+	lunid := 0
+	if iscsi.SharedTargets > 0 {
+		// Volume is packed as a lun into one of the shared targets
+		if targetName, targetID, lunid, err = cs.allocateISCSISharedLun(ctx, cl, iscsi, extentID); err != nil {
+			return
+		}
+	} else {
+		if targetID, err = cs.ensureISCSITarget(ctx, cl, iscsi, targetName); err != nil {
+			return
+		}
+
+		if err = cs.ensureISCSITargetExtent(ctx, cl, targetID, extentID, lunid); err != nil {
+			return
+		}
+	}
+
 	var target *iscsiTarget
-	target, err = cs.getISCSITargetByName(ctx, cl, targetName)
-	if err != nil {
+	if target, err = cs.getISCSITargetByName(ctx, cl, targetName); err != nil {
 		return
 	}
-
 	if target == nil {
-		// Lookup existing auth
-		var auth *iscsiAuth
-		auth, err = cs.getIscsiAuthByUser(ctx, cl, targetName)
-		if err != nil {
-			return
+		return nil, status.Errorf(codes.Unavailable, "iscsi target %q not found", targetName)
+	}
+
+	if auth, err = cs.getIscsiAuthByTarget(ctx, cl, target); err != nil {
+		return
+	}
+
+	// Obtain Target name
+	var basename string
+	if basename, err = cs.getISCSIBasename(ctx, cl); err != nil {
+		return
+	}
+
+	volumeContext = iscsiVolumeContext(iscsi, basename, targetName, lunid, auth)
+
+	return
+}
+
+// ensureISCSITarget returns the id of the target named targetName, creating it with its auth if missing
+func (cs *server) ensureISCSITarget(ctx context.Context, cl *TruenasOapi.Client, iscsi *config.ISCSI, targetName string) (targetID int, err error) {
+	var target *iscsiTarget
+	if target, err = cs.getISCSITargetByName(ctx, cl, targetName); err != nil || target != nil {
+		if target != nil {
+			targetID = target.ID
 		}
 
-		if auth == nil {
-			// Create auth
-			iscsiUsername = targetName
-			iscsiSecret = genIscsiSecret()
-			tag := int(-1)
+		return
+	}
 
-			auth = &iscsiAuth{
-				ID: tag,
-				IscsiAuthCreate0: TruenasOapi.IscsiAuthCreate0{
-					Tag:    &tag,
-					User:   &iscsiUsername,
-					Secret: &iscsiSecret,
-				},
-			}
+	// Lookup existing auth
+	var auth *iscsiAuth
+	auth, err = cs.getIscsiAuthByUser(ctx, cl, targetName)
+	if err != nil {
+		return
+	}
 
-			if auth.ID, err = handleNasCreateResponse(cl.PostIscsiAuth(ctx, auth.IscsiAuthCreate0)); err != nil {
-				return
-			}
-		} else {
-			iscsiUsername = *auth.User
-			iscsiSecret = *auth.Secret
+	if auth == nil {
+		// Create auth
+		iscsiUsername := targetName
+		iscsiSecret := genIscsiSecret()
+		tag := int(-1)
+
+		auth = &iscsiAuth{
+			ID: tag,
+			IscsiAuthCreate0: TruenasOapi.IscsiAuthCreate0{
+				Tag:    &tag,
+				User:   &iscsiUsername,
+				Secret: &iscsiSecret,
+			},
 		}
 
-		// Update auth group
-		if *auth.Tag == -1 {
-			if _, err = handleNasResponse(cl.PutIscsiAuthIdId(ctx, auth.ID, TruenasOapi.IscsiAuthUpdate1{
-				Tag: &auth.ID,
-			})); err != nil {
-				return
-			}
+		if iscsi.MutualCHAP {
+			// Nodes authenticate the target with peer credentials
+			peerUsername := targetName
+			peerSecret := genIscsiSecret()
+
+			auth.Peeruser = &peerUsername
+			auth.Peersecret = &peerSecret
 		}
 
-		// Create target
-		if targetID, err = handleNasCreateResponse(cl.PostIscsiTarget(ctx, TruenasOapi.IscsiTargetCreate0{
-			Name: &targetName,
-			Groups: &[]map[string]interface{}{
-				{
-					"portal":     iscsi.PortalID,
-					"authmethod": "CHAP",
-					"auth":       auth.ID,
-				},
-			},
-		})); err != nil {
+		if auth.ID, err = handleNasCreateResponse(cl.PostIscsiAuth(ctx, auth.IscsiAuthCreate0)); err != nil {
 			return
 		}
-	} else {
-		var auth *iscsiAuth
-		auth, err = cs.getIscsiAuthByTarget(ctx, cl, target)
-		if err != nil {
+	}
+
+	// Update auth group
+	if *auth.Tag == -1 {
+		if _, err = handleNasResponse(cl.PutIscsiAuthIdId(ctx, auth.ID, TruenasOapi.IscsiAuthUpdate1{
+			Tag: &auth.ID,
+		})); err != nil {
 			return
 		}
+	}
 
-		if auth != nil {
-			iscsiUsername = *auth.User
-			iscsiSecret = *auth.Secret
+	authmethod := "CHAP"
+	if auth.Peeruser != nil && *auth.Peeruser != "" {
+		authmethod = "CHAP_MUTUAL"
+	}
+
+	group := map[string]interface{}{
+		"portal":     iscsi.PortalID,
+		"authmethod": authmethod,
+		"auth":       auth.ID,
+	}
+
+	if iscsi.RestrictToNodes {
+		// Nodes are granted access during publish
+		var initiator *iscsiInitiator
+		if initiator, err = cs.ensureISCSIInitiatorGroup(ctx, cl, targetName); err != nil {
+			return
 		}
+
+		group["initiator"] = initiator.ID
 	}
 
-	// Create association
-	lunid := 0
+	// Create target
+	return handleNasCreateResponse(cl.PostIscsiTarget(ctx, TruenasOapi.IscsiTargetCreate0{
+		Name:   &targetName,
+		Groups: &[]map[string]interface{}{group},
+	}))
+}
+
+// ensureISCSITargetExtent associates extent to target as lun lunid
+func (cs *server) ensureISCSITargetExtent(ctx context.Context, cl *TruenasOapi.Client, targetID int, extentID int, lunid int) error {
 	assoccreateresponse, err := cl.PostIscsiTargetextent(ctx, TruenasOapi.IscsiTargetextentCreate0{
 		Target: &targetID,
 		Extent: &extentID,
 		Lunid:  &lunid,
 	})
 	if err != nil {
-		return
+		return err
 	}
 	_, _ = io.ReadAll(assoccreateresponse.Body)
 	_ = assoccreateresponse.Body.Close()
-	switch assoccreateresponse.StatusCode {
-	case 200:
-	default:
-		// Create failed due to conflict or other errors
-		var assoc *http.Response
-		assoc, err = cl.GetIscsiTargetextent(ctx, &TruenasOapi.GetIscsiTargetextentParams{},
-			truenasOapiFilter("target", fmt.Sprintf("%d", targetID)),
-			truenasOapiFilter("extent", fmt.Sprintf("%d", extentID)),
-		)
-		if err != nil {
+	if assoccreateresponse.StatusCode == 200 {
+		return nil
+	}
+
+	// Create failed due to conflict or other errors
+	assocs, err := cs.getISCSITargetExtents(ctx, cl, targetID, extentID)
+	if err != nil {
+		return err
+	}
+	if len(assocs) == 0 {
+		return status.Errorf(codes.Unavailable, "failed creating targetextent (%d, %d)", targetID, extentID)
+	}
+
+	return nil
+}
+
+// iscsiMaxLunsPerTarget is the number of luns a shared target may hold
+const iscsiMaxLunsPerTarget = 1024
+
+// iscsiSharedTargetName returns the name of the i-th shared target on a portal
+func iscsiSharedTargetName(iscsi *config.ISCSI, i int) string {
+	return fmt.Sprintf("truenas-csi-%d-%d", iscsi.PortalID, i)
+}
+
+// allocateISCSISharedLun associates extent to the shared target holding the fewest luns,
+// using the lowest free lun id. An existing association of the extent is returned as is.
+func (cs *server) allocateISCSISharedLun(ctx context.Context, cl *TruenasOapi.Client, iscsi *config.ISCSI, extentID int) (
+	targetName string, targetID int, lunid int, err error) {
+
+	var assoc *iscsiTargetExtent
+	if assoc, err = cs.getISCSIExtentTarget(ctx, cl, extentID); err != nil || assoc != nil {
+		if assoc != nil {
+			var target *iscsiTarget
+			if target, err = cs.getISCSITargetByID(ctx, cl, assoc.Target); err != nil {
+				return
+			}
+			if target == nil {
+				err = status.Errorf(codes.Unavailable, "iscsi target %d not found", assoc.Target)
+
+				return
+			}
+
+			return *target.Name, target.ID, assoc.Lunid, nil
+		}
+
+		return
+	}
+
+	var assocresp []byte
+	if assocresp, err = handleNasResponse(cl.GetIscsiTargetextent(ctx, &TruenasOapi.GetIscsiTargetextentParams{})); err != nil {
+		return
+	}
+
+	var assocs []iscsiTargetExtent
+	if err = json.Unmarshal(assocresp, &assocs); err != nil {
+		err = status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+
+		return
+	}
+
+	luns := make(map[int][]int)
+	for _, assoc := range assocs {
+		luns[assoc.Target] = append(luns[assoc.Target], assoc.Lunid)
+	}
+
+	// Select the least used target, missing targets hold no luns
+	selected, selectedLuns := -1, iscsiMaxLunsPerTarget
+	for i := 0; i < iscsi.SharedTargets; i++ {
+		var target *iscsiTarget
+		if target, err = cs.getISCSITargetByName(ctx, cl, iscsiSharedTargetName(iscsi, i)); err != nil {
 			return
 		}
-		if assoc.StatusCode != 200 {
-			return nil, status.Errorf(codes.Unavailable, "failed querying existing targetextent (%d, %d): not found", targetID, extentID)
+
+		var n int
+		if target != nil {
+			n = len(luns[target.ID])
+		}
+
+		if n < selectedLuns {
+			selected, selectedLuns = i, n
 		}
 	}
 
-	// Obtain Target name
-	var iscsiglobalresp []byte
-	if iscsiglobalresp, err = handleNasResponse(cl.GetIscsiGlobal(ctx)); err != nil {
+	if selected == -1 {
+		err = status.Errorf(codes.ResourceExhausted, "all %d shared iscsi targets are full", iscsi.SharedTargets)
+
 		return
 	}
-	var result TruenasOapi.IscsiGlobalUpdate0
-	if err = json.Unmarshal(iscsiglobalresp, &result); err != nil {
+
+	targetName = iscsiSharedTargetName(iscsi, selected)
+	if targetID, err = cs.ensureISCSITarget(ctx, cl, iscsi, targetName); err != nil {
 		return
 	}
-	if result.Basename == nil {
-		err = status.Errorf(codes.Unavailable, "Error parsing freenas response: missing basename")
-		return
+
+	for slices.Contains(luns[targetID], lunid) {
+		lunid++
+	}
+
+	// Concurrent allocations of the same lun fail, and are retried by the CO
+	err = cs.ensureISCSITargetExtent(ctx, cl, targetID, extentID, lunid)
+
+	return
+}
+
+// getISCSIVolumeContext returns the volume context of an existing iscsi volume,
+// or nil if its extent is not associated to a target
+func (cs *server) getISCSIVolumeContext(ctx context.Context, cl *TruenasOapi.Client, iscsi *config.ISCSI, extentName string) (*volumecontext.VolumeContext, error) {
+	extent, err := cs.getISCSIExtentByName(ctx, cl, extentName)
+	if err != nil || extent == nil {
+		return nil, err
+	}
+
+	assoc, err := cs.getISCSIExtentTarget(ctx, cl, extent.ID)
+	if err != nil || assoc == nil {
+		return nil, err
+	}
+
+	target, err := cs.getISCSITargetByID(ctx, cl, assoc.Target)
+	if err != nil || target == nil {
+		return nil, err
+	}
+
+	auth, err := cs.getIscsiAuthByTarget(ctx, cl, target)
+	if err != nil {
+		return nil, err
+	}
+
+	basename, err := cs.getISCSIBasename(ctx, cl)
+	if err != nil {
+		return nil, err
 	}
 
-	volumeContext = &volumecontext.VolumeContext{
+	return iscsiVolumeContext(iscsi, basename, *target.Name, assoc.Lunid, auth), nil
+}
+
+func iscsiVolumeContext(iscsi *config.ISCSI, basename, targetName string, lunid int, auth *iscsiAuth) *volumecontext.VolumeContext {
+	volumeContext := &volumecontext.VolumeContext{
 		Iscsi: &volumecontext.ISCSI{
-			Portal: iscsi.Portal,
-			Target: fmt.Sprintf("%s:%s", *result.Basename, targetName),
+			Portal:  iscsi.Portal,
+			Portals: iscsi.Portals,
+			Target:  fmt.Sprintf("%s:%s", basename, targetName),
+			Lun:     lunid,
 		},
 	}
 
-	if iscsiUsername != "" {
+	if auth != nil && auth.User != nil && auth.Secret != nil {
 		volumeContext.Iscsi.InboundAuth = &volumecontext.ISCSIAuth{
-			Username: iscsiUsername,
-			Password: iscsiSecret,
+			Username: *auth.User,
+			Password: *auth.Secret,
+		}
+
+		if auth.Peeruser != nil && *auth.Peeruser != "" && auth.Peersecret != nil {
+			volumeContext.Iscsi.OutboundAuth = &volumecontext.ISCSIAuth{
+				Username: *auth.Peeruser,
+				Password: *auth.Peersecret,
+			}
 		}
 	}
 
-	return
+	// Discovery credentials are shared by all volumes, nodes receive them in node stage secrets
+	volumeContext.Iscsi.DiscoveryAuth = iscsi.DiscoveryAuth != nil
+
+	return volumeContext
+}
+
+// getISCSIBasename returns the base name of iscsi targets
+func (cs *server) getISCSIBasename(ctx context.Context, cl *TruenasOapi.Client) (string, error) {
+	iscsiglobalresp, err := handleNasResponse(cl.GetIscsiGlobal(ctx))
+	if err != nil {
+		return "", err
+	}
+	var result TruenasOapi.IscsiGlobalUpdate0
+	if err = json.Unmarshal(iscsiglobalresp, &result); err != nil {
+		return "", err
+	}
+	if result.Basename == nil {
+		return "", status.Errorf(codes.Unavailable, "Error parsing freenas response: missing basename")
+	}
+
+	return *result.Basename, nil
 }
 
 func (cs *server) deleteISCSIVolume(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo) error {
@@ -238,6 +429,19 @@ func (cs *server) deleteISCSIVolume(ctx context.Context, cl *TruenasOapi.Client,
 		}
 	}
 
+	// Lookup initiator group
+	initiator, err := cs.getISCSIInitiatorByComment(ctx, cl, targetName)
+	if err != nil {
+		return err
+	}
+
+	if initiator != nil {
+		// Delete initiator group
+		if _, err = handleNasResponse(cl.DeleteIscsiInitiatorIdId(ctx, initiator.ID)); err != nil {
+			return err
+		}
+	}
+
 	// Lookup extent
 	extent, err := cs.getISCSIExtentByName(ctx, cl, targetName)
 	if err != nil {
@@ -245,6 +449,18 @@ func (cs *server) deleteISCSIVolume(ctx context.Context, cl *TruenasOapi.Client,
 	}
 
 	if extent != nil {
+		// Shared targets are kept, only the lun is removed
+		assoc, err := cs.getISCSIExtentTarget(ctx, cl, extent.ID)
+		if err != nil {
+			return err
+		}
+
+		if assoc != nil {
+			if _, err = handleNasResponse(cl.DeleteIscsiTargetextentIdId(ctx, assoc.ID, false)); err != nil {
+				return err
+			}
+		}
+
 		// Delete extent
 		if _, err := handleNasResponse(cl.DeleteIscsiExtentIdId(ctx, extent.ID, TruenasOapi.IscsiExtentDelete{})); err != nil {
 			return status.Errorf(codes.Unavailable, "Error during call to Nas: %+v", err)
@@ -254,6 +470,280 @@ func (cs *server) deleteISCSIVolume(ctx context.Context, cl *TruenasOapi.Client,
 	return nil
 }
 
+// checkISCSIVolume returns a description of the problem with the iscsi share of a volume, or "" if it is healthy
+func (cs *server) checkISCSIVolume(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo) (string, error) {
+	_, extentName := path.Split(di.ID)
+
+	extent, err := cs.getISCSIExtentByName(ctx, cl, extentName)
+	if err != nil {
+		return "", err
+	}
+	if extent == nil {
+		return "iscsi extent is missing", nil
+	}
+
+	assoc, err := cs.getISCSIExtentTarget(ctx, cl, extent.ID)
+	if err != nil {
+		return "", err
+	}
+	if assoc == nil {
+		return "iscsi target-extent association is missing", nil
+	}
+
+	target, err := cs.getISCSITargetByID(ctx, cl, assoc.Target)
+	if err != nil {
+		return "", err
+	}
+	if target == nil {
+		return "iscsi target is missing", nil
+	}
+
+	return "", nil
+}
+
+// iscsiNoInitiator is a placeholder initiator name. Initiator groups without initiators
+// allow access to all initiators, so unpublished targets are restricted to this one.
+const iscsiNoInitiator = "iqn.2021-01.net.dravanet.truenas-csi:none"
+
+// publishISCSIVolume grants access to a volume's target for node in restricted mode
+func (cs *server) publishISCSIVolume(ctx context.Context, cl *TruenasOapi.Client, iscsi *config.ISCSI, di *datasetInfo, nodeID string, capability *csi.VolumeCapability) error {
+	if iscsi == nil || !iscsi.RestrictToNodes {
+		return nil
+	}
+
+	iqn := nodeid.Parse(nodeID).IQN
+	if iqn == "" {
+		return status.Errorf(codes.FailedPrecondition, "node %q did not report its iSCSI initiator name, it must be started with -csi-node-id-iqn", nodeID)
+	}
+
+	_, targetName := path.Split(di.ID)
+
+	target, err := cs.getISCSITargetByName(ctx, cl, targetName)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return status.Errorf(codes.NotFound, "iscsi target for %q not found", di.ID)
+	}
+
+	initiator, err := cs.ensureISCSIInitiatorGroup(ctx, cl, targetName)
+	if err != nil {
+		return err
+	}
+
+	if err = cs.bindISCSIInitiatorGroup(ctx, cl, target, initiator); err != nil {
+		return err
+	}
+
+	var initiators []string
+	for _, name := range initiator.Initiators {
+		switch name {
+		case iqn:
+			return nil
+		case iscsiNoInitiator:
+		default:
+			initiators = append(initiators, name)
+		}
+	}
+
+	if len(initiators) > 0 {
+		switch capability.GetAccessMode().GetMode() {
+		case csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+			csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY,
+			csi.VolumeCapability_AccessMode_SINGLE_NODE_SINGLE_WRITER,
+			csi.VolumeCapability_AccessMode_SINGLE_NODE_MULTI_WRITER:
+			return status.Errorf(codes.FailedPrecondition, "volume %q is already published to %v", di.ID, initiators)
+		}
+	}
+
+	return cs.updateISCSIInitiators(ctx, cl, initiator, append(initiators, iqn))
+}
+
+// unpublishISCSIVolume revokes access to a volume's target from node in restricted mode
+func (cs *server) unpublishISCSIVolume(ctx context.Context, cl *TruenasOapi.Client, iscsi *config.ISCSI, di *datasetInfo, nodeID string) error {
+	if iscsi == nil || !iscsi.RestrictToNodes {
+		return nil
+	}
+
+	_, targetName := path.Split(di.ID)
+
+	initiator, err := cs.getISCSIInitiatorByComment(ctx, cl, targetName)
+	if err != nil || initiator == nil {
+		return err
+	}
+
+	initiators := []string{}
+	if nodeID != "" {
+		iqn := nodeid.Parse(nodeID).IQN
+
+		for _, name := range initiator.Initiators {
+			if name != iqn && name != iscsiNoInitiator {
+				initiators = append(initiators, name)
+			}
+		}
+	}
+
+	return cs.updateISCSIInitiators(ctx, cl, initiator, initiators)
+}
+
+// updateISCSIInitiators sets initiators of an initiator group, denying access to all if empty
+func (cs *server) updateISCSIInitiators(ctx context.Context, cl *TruenasOapi.Client, initiator *iscsiInitiator, initiators []string) error {
+	if len(initiators) == 0 {
+		initiators = []string{iscsiNoInitiator}
+	}
+
+	if slices.Equal(initiators, initiator.Initiators) {
+		return nil
+	}
+
+	names := make([]interface{}, len(initiators))
+	for i, name := range initiators {
+		names[i] = name
+	}
+
+	_, err := handleNasResponse(cl.PutIscsiInitiatorIdId(ctx, initiator.ID, TruenasOapi.IscsiInitiatorUpdate1{
+		Initiators: &names,
+	}))
+
+	return err
+}
+
+type iscsiInitiator struct {
+	ID         int      `json:"id"`
+	Comment    string   `json:"comment"`
+	Initiators []string `json:"initiators"`
+}
+
+// ensureISCSIInitiatorGroup returns the initiator group of a target, creating it if missing
+func (cs *server) ensureISCSIInitiatorGroup(ctx context.Context, cl *TruenasOapi.Client, targetName string) (*iscsiInitiator, error) {
+	initiator, err := cs.getISCSIInitiatorByComment(ctx, cl, targetName)
+	if err != nil || initiator != nil {
+		return initiator, err
+	}
+
+	initiator = &iscsiInitiator{
+		Comment:    targetName,
+		Initiators: []string{iscsiNoInitiator},
+	}
+
+	names := []interface{}{iscsiNoInitiator}
+	if initiator.ID, err = handleNasCreateResponse(cl.PostIscsiInitiator(ctx, TruenasOapi.IscsiInitiatorCreate0{
+		Comment:    &targetName,
+		Initiators: &names,
+	})); err != nil {
+		return nil, err
+	}
+
+	return initiator, nil
+}
+
+// bindISCSIInitiatorGroup binds initiator group to target's group, if not yet bound
+func (cs *server) bindISCSIInitiatorGroup(ctx context.Context, cl *TruenasOapi.Client, target *iscsiTarget, initiator *iscsiInitiator) error {
+	if len(target.Groups) != 1 {
+		return status.Errorf(codes.Unavailable, "Unexpected result: target has %d groups: %+v", len(target.Groups), target)
+	}
+
+	group := target.Groups[0]
+	if group.Initiator != nil && *group.Initiator == initiator.ID {
+		return nil
+	}
+
+	_, err := handleNasResponse(cl.PutIscsiTargetIdId(ctx, target.ID, TruenasOapi.IscsiTargetUpdate1{
+		Groups: &[]map[string]interface{}{
+			{
+				"portal":     group.Portal,
+				"initiator":  initiator.ID,
+				"authmethod": group.Authmethod,
+				"auth":       group.Auth,
+			},
+		},
+	}))
+
+	return err
+}
+
+func (cs *server) getISCSIInitiatorByComment(ctx context.Context, cl *TruenasOapi.Client, comment string) (ret *iscsiInitiator, err error) {
+	var initiatorresp []byte
+	if initiatorresp, err = handleNasResponse(cl.GetIscsiInitiator(ctx, &TruenasOapi.GetIscsiInitiatorParams{}, truenasOapiFilter("comment", comment))); err != nil {
+		return
+	}
+
+	var initiators []iscsiInitiator
+	if err = json.Unmarshal(initiatorresp, &initiators); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+	}
+	if len(initiators) > 1 {
+		return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %+v", initiators)
+	}
+	if len(initiators) == 1 {
+		if initiators[0].Comment != comment {
+			return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %+v", initiators)
+		}
+
+		ret = &initiators[0]
+	}
+
+	return
+}
+
+type iscsiTargetExtent struct {
+	ID     int `json:"id"`
+	Target int `json:"target"`
+	Extent int `json:"extent"`
+	Lunid  int `json:"lunid"`
+}
+
+// getISCSITargetExtents returns associations between target and extent
+func (cs *server) getISCSITargetExtents(ctx context.Context, cl *TruenasOapi.Client, targetID int, extentID int) (ret []iscsiTargetExtent, err error) {
+	var assocresp []byte
+	if assocresp, err = handleNasResponse(cl.GetIscsiTargetextent(ctx, &TruenasOapi.GetIscsiTargetextentParams{},
+		truenasOapiFilter("target", fmt.Sprintf("%d", targetID)),
+		truenasOapiFilter("extent", fmt.Sprintf("%d", extentID)),
+	)); err != nil {
+		return
+	}
+
+	var assocs []iscsiTargetExtent
+	if err = json.Unmarshal(assocresp, &assocs); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+	}
+
+	for _, assoc := range assocs {
+		if assoc.Target != targetID || assoc.Extent != extentID {
+			return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %+v", assocs)
+		}
+	}
+
+	return assocs, nil
+}
+
+// getISCSIExtentTarget returns the association of extent to its target, or nil if not associated
+func (cs *server) getISCSIExtentTarget(ctx context.Context, cl *TruenasOapi.Client, extentID int) (ret *iscsiTargetExtent, err error) {
+	var assocresp []byte
+	if assocresp, err = handleNasResponse(cl.GetIscsiTargetextent(ctx, &TruenasOapi.GetIscsiTargetextentParams{},
+		truenasOapiFilter("extent", fmt.Sprintf("%d", extentID)),
+	)); err != nil {
+		return
+	}
+
+	var assocs []iscsiTargetExtent
+	if err = json.Unmarshal(assocresp, &assocs); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+	}
+	if len(assocs) > 1 {
+		return nil, status.Errorf(codes.Unavailable, "Unexpected result: extent is associated to multiple targets: %+v", assocs)
+	}
+	if len(assocs) == 1 {
+		if assocs[0].Extent != extentID {
+			return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %+v", assocs)
+		}
+
+		ret = &assocs[0]
+	}
+
+	return
+}
+
 type iscsiExtent struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
@@ -288,6 +778,8 @@ type iscsiTarget struct {
 	ID int `json:"id"`
 
 	Groups []struct {
+		Portal     int    `json:"portal"`
+		Initiator  *int   `json:"initiator"`
 		Auth       *int   `json:"auth"`
 		Authmethod string `json:"authmethod"`
 	} `json:"groups,omitempty"`
@@ -320,6 +812,31 @@ func (cs *server) getISCSITargetByName(ctx context.Context, cl *TruenasOapi.Clie
 	return
 }
 
+func (cs *server) getISCSITargetByID(ctx context.Context, cl *TruenasOapi.Client, id int) (ret *iscsiTarget, err error) {
+	var targetresp []byte
+	if targetresp, err = handleNasResponse(cl.GetIscsiTarget(ctx, &TruenasOapi.GetIscsiTargetParams{}, truenasOapiFilter("id", fmt.Sprintf("%d", id)))); err != nil {
+		return
+	}
+
+	var targets []iscsiTarget
+	if err = json.Unmarshal(targetresp, &targets); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+	}
+
+	if len(targets) > 1 {
+		return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %+v", targets)
+	}
+	if len(targets) == 1 {
+		if targets[0].ID != id || targets[0].Name == nil {
+			return nil, status.Errorf(codes.Unavailable, "Unexpected result from NAS: %+v", targets)
+		}
+
+		ret = &targets[0]
+	}
+
+	return
+}
+
 type iscsiAuth struct {
 	ID int `json:"id"`
 	TruenasOapi.IscsiAuthCreate0
@@ -393,3 +910,77 @@ func genIscsiSecret() string {
 
 	return base64.StdEncoding.EncodeToString(password)
 }
+
+// ensureISCSIDiscoveryAuth ensures discovery credentials exist and are required by the portal.
+// The portal is configured once for each iscsi configuration, until the configuration is reloaded.
+func (cs *server) ensureISCSIDiscoveryAuth(ctx context.Context, cl *TruenasOapi.Client, iscsi *config.ISCSI) error {
+	if _, ok := cs.discoveryConfigured.Load(iscsi); ok {
+		return nil
+	}
+
+	da := iscsi.DiscoveryAuth
+
+	auth, err := cs.getIscsiAuthByUser(ctx, cl, da.Username)
+	if err != nil {
+		return err
+	}
+
+	var peerUsername, peerSecret *string
+	if da.PeerUsername != "" {
+		peerUsername, peerSecret = &da.PeerUsername, &da.PeerSecret
+	}
+
+	switch {
+	case auth == nil:
+		if _, err = handleNasCreateResponse(cl.PostIscsiAuth(ctx, TruenasOapi.IscsiAuthCreate0{
+			Tag:        &da.Tag,
+			User:       &da.Username,
+			Secret:     &da.Secret,
+			Peeruser:   peerUsername,
+			Peersecret: peerSecret,
+		})); err != nil {
+			return err
+		}
+	case auth.Tag == nil || *auth.Tag != da.Tag || auth.Secret == nil || *auth.Secret != da.Secret ||
+		(peerUsername != nil && (auth.Peeruser == nil || *auth.Peeruser != da.PeerUsername || auth.Peersecret == nil || *auth.Peersecret != da.PeerSecret)):
+		if _, err = handleNasResponse(cl.PutIscsiAuthIdId(ctx, auth.ID, TruenasOapi.IscsiAuthUpdate1{
+			Tag:        &da.Tag,
+			Secret:     &da.Secret,
+			Peeruser:   peerUsername,
+			Peersecret: peerSecret,
+		})); err != nil {
+			return err
+		}
+	}
+
+	portalresp, err := handleNasResponse(cl.GetIscsiPortalIdId(ctx, iscsi.PortalID, &TruenasOapi.GetIscsiPortalIdIdParams{}))
+	if err != nil {
+		return err
+	}
+
+	var portal struct {
+		DiscoveryAuthmethod string `json:"discovery_authmethod"`
+		DiscoveryAuthgroup  *int   `json:"discovery_authgroup"`
+	}
+	if err = json.Unmarshal(portalresp, &portal); err != nil {
+		return status.Errorf(codes.Unavailable, "Error parsing result from NAS: %+v", err)
+	}
+
+	authmethod := TruenasOapi.IscsiPortalUpdate1DiscoveryAuthmethod("CHAP")
+	if peerUsername != nil {
+		authmethod = "CHAP_MUTUAL"
+	}
+
+	if portal.DiscoveryAuthmethod != string(authmethod) || portal.DiscoveryAuthgroup == nil || *portal.DiscoveryAuthgroup != da.Tag {
+		if _, err = handleNasResponse(cl.PutIscsiPortalIdId(ctx, iscsi.PortalID, TruenasOapi.IscsiPortalUpdate1{
+			DiscoveryAuthmethod: &authmethod,
+			DiscoveryAuthgroup:  &da.Tag,
+		})); err != nil {
+			return err
+		}
+	}
+
+	cs.discoveryConfigured.Store(iscsi, true)
+
+	return nil
+}
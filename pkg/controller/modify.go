@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"context"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+)
+
+// Mutable parameters, accepted by CreateVolume and ControllerModifyVolume
+const (
+	compressionParameter     = config.PropertyPrefix + config.PropertyCompression
+	syncParameter            = config.PropertyPrefix + config.PropertySync
+	atimeParameter           = config.PropertyPrefix + config.PropertyAtime
+	recordsizeParameter      = config.PropertyPrefix + config.PropertyRecordsize
+	sparseParameter          = "truenas-csi.dravanet.net/sparse"
+	allowedNetworksParameter = "truenas-csi.dravanet.net/allowedNetworks"
+
+	// encryptionKeyIDParameter is an opaque identifier of the key in secrets,
+	// ControllerModifyVolume changes the key of an encrypted volume when it is set
+	encryptionKeyIDParameter = "truenas-csi.dravanet.net/encryptionKeyId"
+)
+
+// volumeModification holds changes requested through mutable parameters
+type volumeModification struct {
+	compression     *string
+	sync            *string
+	atime           *string
+	recordsize      *string
+	sparse          *bool
+	allowedNetworks *[]string
+	encryptionKeyID *string
+}
+
+// Modify volume
+func (cs *server) ControllerModifyVolume(ctx context.Context, req *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No VolumeId specified")
+	}
+
+	// from here req is not null
+
+	mod, err := parseMutableParameters(req.MutableParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.VolumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	di, err := cs.getDataset(ctx, cl, dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil || strings.Contains(di.Comments, retainedKey) {
+		return nil, status.Errorf(codes.NotFound, "Volume does not exist")
+	}
+
+	cfg := nas.GetConfigurationForRootDataset(path.Dir(dataset))
+	if cfg == nil {
+		return nil, status.Errorf(codes.NotFound, "Volume %q is not under a configured root dataset", req.VolumeId)
+	}
+
+	if mod.encryptionKeyID != nil {
+		if err = cs.changeVolumeKey(ctx, cl, di, req.Secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cs.modifyVolume(ctx, cl, cfg.NFS, di, mod); err != nil {
+		return nil, err
+	}
+
+	return &csi.ControllerModifyVolumeResponse{}, nil
+}
+
+// parseMutableParameters validates parameters, returning the requested modification
+func parseMutableParameters(parameters map[string]string) (*volumeModification, error) {
+	mod := &volumeModification{}
+
+	for key, value := range parameters {
+		switch key {
+		case compressionParameter, syncParameter, atimeParameter, recordsizeParameter:
+			property := strings.TrimPrefix(key, config.PropertyPrefix)
+			v, err := config.ValidateProperty(property, value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid value for %s: %q", key, value)
+			}
+
+			switch property {
+			case config.PropertyCompression:
+				mod.compression = &v
+			case config.PropertySync:
+				mod.sync = &v
+			case config.PropertyAtime:
+				mod.atime = &v
+			case config.PropertyRecordsize:
+				mod.recordsize = &v
+			}
+
+		case sparseParameter:
+			sparse, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid value for %s: %q", key, value)
+			}
+			mod.sparse = &sparse
+
+		case allowedNetworksParameter:
+			networks := []string{}
+			for _, network := range strings.Split(value, ",") {
+				if network = strings.TrimSpace(network); network == "" {
+					continue
+				}
+				if _, _, err := net.ParseCIDR(network); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "Invalid network in %s: %q", key, network)
+				}
+				networks = append(networks, network)
+			}
+			mod.allowedNetworks = &networks
+
+		case encryptionKeyIDParameter:
+			mod.encryptionKeyID = &value
+
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unsupported mutable parameter: %s", key)
+		}
+	}
+
+	return mod, nil
+}
+
+// modifyVolume applies a modification to the dataset and share of a volume
+func (cs *server) modifyVolume(ctx context.Context, cl *TruenasOapi.Client, nfs *config.NFS, di *datasetInfo, mod *volumeModification) error {
+	update := TruenasOapi.PoolDatasetUpdate1{}
+	changed := false
+
+	if mod.compression != nil {
+		compression := TruenasOapi.PoolDatasetUpdate1Compression(*mod.compression)
+		update.Compression = &compression
+		changed = true
+	}
+	if mod.sync != nil {
+		sync := TruenasOapi.PoolDatasetUpdate1Sync(*mod.sync)
+		update.Sync = &sync
+		changed = true
+	}
+	if mod.atime != nil {
+		atime := TruenasOapi.PoolDatasetUpdate1Atime(*mod.atime)
+		update.Atime = &atime
+		changed = true
+	}
+	if mod.recordsize != nil {
+		if di.Type != "FILESYSTEM" {
+			return status.Errorf(codes.InvalidArgument, "%s is supported for filesystems only", recordsizeParameter)
+		}
+		recordsize := TruenasOapi.PoolDatasetUpdate1Recordsize(*mod.recordsize)
+		update.Recordsize = &recordsize
+		changed = true
+	}
+	if mod.sparse != nil {
+		// Sparse volumes do not reserve space, others reserve their full size
+		refreservation := 0
+		if !*mod.sparse {
+			switch {
+			case di.Type == "VOLUME" && di.Volsize != nil:
+				refreservation = int(*di.Volsize)
+			case di.Type == "FILESYSTEM" && di.Refquota != nil:
+				refreservation = int(*di.Refquota)
+			}
+		}
+		update.Refreservation = &refreservation
+		changed = true
+	}
+
+	if mod.allowedNetworks != nil {
+		if di.Type != "FILESYSTEM" {
+			return status.Errorf(codes.InvalidArgument, "%s is supported for nfs volumes only", allowedNetworksParameter)
+		}
+		if nfs != nil && nfs.RestrictToNodes {
+			return status.Errorf(codes.InvalidArgument, "%s is not supported with restrictToNodes", allowedNetworksParameter)
+		}
+	}
+
+	if changed {
+		if _, err := handleNasResponse(cl.PutPoolDatasetIdId(ctx, di.ID, update)); err != nil {
+			return err
+		}
+	}
+
+	if mod.allowedNetworks != nil {
+		if err := cs.updateNFSShareNetworks(ctx, cl, di, *mod.allowedNetworks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 
 	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/nodeid"
 	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
 	"github.com/dravanet/truenas-csi/pkg/volumecontext"
 )
@@ -33,7 +35,13 @@ func (cs *server) createNFSVolume(ctx context.Context, cl *TruenasOapi.Client, n
 
 		enabled := true
 		maprootuser := "root"
+		if nfs.MaprootUser != "" {
+			maprootuser = nfs.MaprootUser
+		}
 		maprootgroup := "wheel"
+		if nfs.MaprootGroup != "" {
+			maprootgroup = nfs.MaprootGroup
+		}
 
 		postBody := TruenasOapi.SharingNfsCreate0{
 			Enabled:      &enabled,
@@ -43,6 +51,15 @@ func (cs *server) createNFSVolume(ctx context.Context, cl *TruenasOapi.Client, n
 			MaprootUser:  &maprootuser,
 			MaprootGroup: &maprootgroup,
 		}
+		if nfs.Security != "" {
+			postBody.Security = &[]string{strings.ToUpper(nfs.Security)}
+		}
+		if nfs.RestrictToNodes {
+			// Share is enabled for publishing nodes only
+			enabled = false
+			postBody.Hosts = &[]string{}
+			postBody.Networks = &[]string{}
+		}
 		switch product_type {
 		case "SCALE":
 			postBody.Path = &paths[0]
@@ -62,13 +79,25 @@ func (cs *server) createNFSVolume(ctx context.Context, cl *TruenasOapi.Client, n
 		}
 	}
 
-	volumeContext = &volumecontext.VolumeContext{
+	volumeContext = nfsVolumeContext(nfs, dataset)
+
+	return
+}
+
+// nfsVolumeContext returns the volume context of an nfs volume at dataset
+func nfsVolumeContext(nfs *config.NFS, dataset string) *volumecontext.VolumeContext {
+	volumeContext := &volumecontext.VolumeContext{
 		Nfs: &volumecontext.NFS{
-			Address: fmt.Sprintf("%s:%s", nfs.Server, paths[0]),
+			Address:      fmt.Sprintf("%s:%s", nfs.Server, path.Join("/mnt", dataset)),
+			MountOptions: nfs.MountOptions,
 		},
 	}
+	if nfs.Kerberized() {
+		volumeContext.Nfs.Security = nfs.Security
+		volumeContext.Nfs.Version = nfs.Version
+	}
 
-	return
+	return volumeContext
 }
 
 func (cs *server) deleteNFSVolume(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo) error {
@@ -88,11 +117,132 @@ func (cs *server) deleteNFSVolume(ctx context.Context, cl *TruenasOapi.Client, d
 	return nil
 }
 
+// publishNFSVolume adds node to the hosts of a volume's share in restricted mode
+func (cs *server) publishNFSVolume(ctx context.Context, cl *TruenasOapi.Client, nfs *config.NFS, di *datasetInfo, nodeID string) error {
+	if nfs == nil || !nfs.RestrictToNodes {
+		return nil
+	}
+
+	share, err := cs.getNFSShareByComment(ctx, cl, di.Comments)
+	if err != nil {
+		return err
+	}
+	if share == nil {
+		return status.Errorf(codes.NotFound, "nfs share for %q not found", di.Comments)
+	}
+
+	address := nodeid.Parse(nodeID).Address
+
+	for _, host := range share.Hosts {
+		if host == address && share.isEnabled() {
+			return nil
+		}
+	}
+
+	return cs.updateNFSShareHosts(ctx, cl, share, append(share.Hosts, address))
+}
+
+// unpublishNFSVolume removes node from the hosts of a volume's share in restricted mode
+func (cs *server) unpublishNFSVolume(ctx context.Context, cl *TruenasOapi.Client, nfs *config.NFS, di *datasetInfo, nodeID string) error {
+	if nfs == nil || !nfs.RestrictToNodes {
+		return nil
+	}
+
+	share, err := cs.getNFSShareByComment(ctx, cl, di.Comments)
+	if err != nil || share == nil {
+		return err
+	}
+
+	hosts := make([]string, 0, len(share.Hosts))
+	if nodeID != "" {
+		address := nodeid.Parse(nodeID).Address
+
+		for _, host := range share.Hosts {
+			if host != address {
+				hosts = append(hosts, host)
+			}
+		}
+
+		if len(hosts) == len(share.Hosts) {
+			return nil
+		}
+	}
+
+	return cs.updateNFSShareHosts(ctx, cl, share, hosts)
+}
+
+// updateNFSShareHosts sets hosts of a share, enabling it only if there are hosts to serve
+func (cs *server) updateNFSShareHosts(ctx context.Context, cl *TruenasOapi.Client, share *nfsShare, hosts []string) error {
+	enabled := len(hosts) > 0
+
+	_, err := handleNasResponse(cl.PutSharingNfsIdId(ctx, *share.ID, TruenasOapi.SharingNfsUpdate1{
+		Enabled: &enabled,
+		Hosts:   &hosts,
+		// mapping settings are always sent, preserve them
+		MaprootUser:  share.MaprootUser,
+		MaprootGroup: share.MaprootGroup,
+		MapallUser:   share.MapallUser,
+		MapallGroup:  share.MapallGroup,
+	}))
+
+	return err
+}
+
+// updateNFSShareNetworks sets allowed networks of a volume's share
+func (cs *server) updateNFSShareNetworks(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo, networks []string) error {
+	share, err := cs.getNFSShareByComment(ctx, cl, di.Comments)
+	if err != nil {
+		return err
+	}
+	if share == nil {
+		return status.Errorf(codes.NotFound, "nfs share for %q not found", di.Comments)
+	}
+
+	_, err = handleNasResponse(cl.PutSharingNfsIdId(ctx, *share.ID, TruenasOapi.SharingNfsUpdate1{
+		Networks: &networks,
+		// mapping settings are always sent, preserve them
+		MaprootUser:  share.MaprootUser,
+		MaprootGroup: share.MaprootGroup,
+		MapallUser:   share.MapallUser,
+		MapallGroup:  share.MapallGroup,
+	}))
+
+	return err
+}
+
+// checkNFSVolume returns a description of the problem with the nfs share of a volume, or "" if it is healthy
+func (cs *server) checkNFSVolume(ctx context.Context, cl *TruenasOapi.Client, nfs *config.NFS, di *datasetInfo) (string, error) {
+	share, err := cs.getNFSShareByComment(ctx, cl, di.Comments)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case share == nil:
+		return "nfs share is missing", nil
+	case !share.isEnabled() && (nfs == nil || !nfs.RestrictToNodes || len(share.Hosts) > 0):
+		// in restricted mode, shares without hosts are disabled
+		return "nfs share is disabled", nil
+	}
+
+	return "", nil
+}
+
 type nfsShare struct {
-	ID      *int     `json:"id"`
-	Comment *string  `json:"comment"`
-	Paths   []string `json:"paths"`
-	Path    *string  `json:"path"`
+	ID           *int     `json:"id"`
+	Comment      *string  `json:"comment"`
+	Paths        []string `json:"paths"`
+	Path         *string  `json:"path"`
+	Enabled      *bool    `json:"enabled"`
+	Hosts        []string `json:"hosts"`
+	MaprootUser  *string  `json:"maproot_user"`
+	MaprootGroup *string  `json:"maproot_group"`
+	MapallUser   *string  `json:"mapall_user"`
+	MapallGroup  *string  `json:"mapall_group"`
+}
+
+func (share *nfsShare) isEnabled() bool {
+	return share.Enabled == nil || *share.Enabled
 }
 
 func (cs *server) getNFSShareByComment(ctx context.Context, cl *TruenasOapi.Client, comment string) (share *nfsShare, err error) {
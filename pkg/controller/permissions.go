@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+)
+
+// datasetPermission returns the permissions of a new filesystem volume's root,
+// parameters overriding the configuration's permissions
+func datasetPermission(cfg *config.Configuration, parameters map[string]string) (*TruenasOapi.PoolDatasetPermission1, error) {
+	var perm config.Permissions
+	if cfg.Permissions != nil {
+		perm = *cfg.Permissions
+	}
+
+	if user, ok := parameters[config.UserParameter]; ok {
+		perm.User = user
+	}
+	if group, ok := parameters[config.GroupParameter]; ok {
+		perm.Group = group
+	}
+	// mode and acl replace each other
+	if mode, ok := parameters[config.ModeParameter]; ok {
+		perm.Mode, perm.ACL = mode, ""
+	}
+	if acl, ok := parameters[config.ACLParameter]; ok {
+		perm.Mode, perm.ACL = "", acl
+	}
+
+	if err := perm.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%+v", err)
+	}
+
+	permission := &TruenasOapi.PoolDatasetPermission1{
+		Acl: &[]map[string]interface{}{},
+	}
+	if perm.User != "" {
+		permission.User = &perm.User
+	}
+	if perm.Group != "" {
+		permission.Group = &perm.Group
+	}
+
+	switch {
+	case perm.ACL != "":
+		acl, _ := perm.ACLEntries()
+		permission.Acl = &acl
+	case perm.Mode != "":
+		permission.Mode = &perm.Mode
+	case perm.Group != "":
+		// Group-writable, new files inherit the group
+		mode := "2770"
+		permission.Mode = &mode
+	default:
+		// World-writable
+		mode := "0777"
+		permission.Mode = &mode
+	}
+
+	return permission, nil
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+)
+
+// datasetProperties holds zfs properties of a new volume, in the form the TrueNAS API expects
+type datasetProperties map[string]string
+
+// parseParameters validates CreateVolume parameters, returning zfs properties of the volume,
+// parameters overriding the configuration's properties
+func parseParameters(cfg *config.Configuration, parameters map[string]string) (datasetProperties, error) {
+	props := datasetProperties{}
+	for name, value := range cfg.Properties {
+		props[name], _ = config.ValidateProperty(name, value)
+	}
+
+	for key, value := range parameters {
+		switch key {
+		case config.NasSelector, config.ConfigSelector, config.ProtocolSelector,
+			config.UserParameter, config.GroupParameter, config.ModeParameter, config.ACLParameter,
+			config.EncryptionParameter:
+			continue
+		}
+
+		// Parameters of the CO or other components, e.g. csi.storage.k8s.io/pvc/name, are ignored
+		name, ok := strings.CutPrefix(key, config.PropertyPrefix)
+		if !ok {
+			continue
+		}
+
+		v, err := config.ValidateProperty(name, value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Unsupported parameter %s: %+v", key, err)
+		}
+		props[name] = v
+	}
+
+	return props, nil
+}
+
+// applyCreate sets properties on a create request. Properties not applicable
+// to the type of the volume are ignored. Readonly is applied by applyReadonly.
+func (props datasetProperties) applyCreate(create *TruenasOapi.PoolDatasetCreate0, volume bool) {
+	for name, value := range props {
+		switch name {
+		case config.PropertyCompression:
+			v := TruenasOapi.PoolDatasetCreate0Compression(value)
+			create.Compression = &v
+		case config.PropertySync:
+			v := TruenasOapi.PoolDatasetCreate0Sync(value)
+			create.Sync = &v
+		case config.PropertyDedup:
+			v := TruenasOapi.PoolDatasetCreate0Deduplication(value)
+			create.Deduplication = &v
+		case config.PropertyCopies:
+			v, _ := strconv.Atoi(value)
+			create.Copies = &v
+		case config.PropertyVolblocksize:
+			if volume {
+				v := TruenasOapi.PoolDatasetCreate0Volblocksize(value)
+				create.Volblocksize = &v
+			}
+		case config.PropertyAtime:
+			if !volume {
+				v := TruenasOapi.PoolDatasetCreate0Atime(value)
+				create.Atime = &v
+			}
+		case config.PropertyRecordsize:
+			if !volume {
+				v := TruenasOapi.PoolDatasetCreate0Recordsize(value)
+				create.Recordsize = &v
+			}
+		case config.PropertySnapdir:
+			if !volume {
+				v := TruenasOapi.PoolDatasetCreate0Snapdir(value)
+				create.Snapdir = &v
+			}
+		}
+	}
+}
+
+// applyUpdate sets properties on an update request of a clone. Volblocksize
+// is inherited from the origin, readonly is applied by applyReadonly.
+func (props datasetProperties) applyUpdate(update *TruenasOapi.PoolDatasetUpdate1, volume bool) {
+	for name, value := range props {
+		switch name {
+		case config.PropertyCompression:
+			v := TruenasOapi.PoolDatasetUpdate1Compression(value)
+			update.Compression = &v
+		case config.PropertySync:
+			v := TruenasOapi.PoolDatasetUpdate1Sync(value)
+			update.Sync = &v
+		case config.PropertyDedup:
+			v := TruenasOapi.PoolDatasetUpdate1Deduplication(value)
+			update.Deduplication = &v
+		case config.PropertyCopies:
+			v, _ := strconv.Atoi(value)
+			update.Copies = &v
+		case config.PropertyAtime:
+			if !volume {
+				v := TruenasOapi.PoolDatasetUpdate1Atime(value)
+				update.Atime = &v
+			}
+		case config.PropertyRecordsize:
+			if !volume {
+				v := TruenasOapi.PoolDatasetUpdate1Recordsize(value)
+				update.Recordsize = &v
+			}
+		case config.PropertySnapdir:
+			if !volume {
+				v := TruenasOapi.PoolDatasetUpdate1Snapdir(value)
+				update.Snapdir = &v
+			}
+		}
+	}
+}
+
+// applyReadonly returns an update request setting readonly, or nil if it is not requested.
+// Readonly is set once the volume is ready, as setting permissions writes the dataset.
+func (props datasetProperties) applyReadonly() *TruenasOapi.PoolDatasetUpdate1 {
+	value, ok := props[config.PropertyReadonly]
+	if !ok {
+		return nil
+	}
+
+	v := TruenasOapi.PoolDatasetUpdate1Readonly(value)
+
+	return &TruenasOapi.PoolDatasetUpdate1{Readonly: &v}
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+)
+
+// autoProtocols lists protocols in order of preference for automatic selection
+var autoProtocols = []string{config.ProtocolISCSI, config.ProtocolNFS, config.ProtocolSMB}
+
+// protocolSupports returns true if volumes shared over protocol provide capability
+func protocolSupports(protocol string, capability *csi.VolumeCapability) bool {
+	switch {
+	case capability.GetBlock() != nil:
+		return protocol == config.ProtocolISCSI
+	case capability.GetMount() != nil:
+		switch capability.GetAccessMode().GetMode() {
+		case csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY, csi.VolumeCapability_AccessMode_MULTI_NODE_SINGLE_WRITER, csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER:
+			// multi-node access with iscsi is not allowed
+			return protocol != config.ProtocolISCSI
+		}
+	}
+
+	return true
+}
+
+// protocolAllowed returns true if protocol may be used in configuration
+func protocolAllowed(cfg *config.Configuration, protocol string) bool {
+	return len(cfg.AllowedProtocols) == 0 || slices.Contains(cfg.AllowedProtocols, protocol)
+}
+
+// protocolConfigured returns true if configuration has a sub-configuration for protocol
+func protocolConfigured(cfg *config.Configuration, protocol string) bool {
+	switch protocol {
+	case config.ProtocolNFS:
+		return cfg.NFS != nil
+	case config.ProtocolISCSI:
+		return cfg.ISCSI != nil
+	case config.ProtocolSMB:
+		return cfg.SMB != nil
+	}
+
+	return false
+}
+
+// sourceTypeSupports returns true if a volume cloned from a dataset of sourceType may be shared over protocol
+func sourceTypeSupports(sourceType string, protocol string) bool {
+	switch sourceType {
+	case "VOLUME":
+		return protocol == config.ProtocolISCSI
+	case "FILESYSTEM":
+		return protocol != config.ProtocolISCSI
+	}
+
+	return true
+}
+
+// selectProtocol returns the protocol a new volume is shared over. A requested protocol must be allowed
+// and provide all capabilities, with auto the first configured and allowed one providing them is selected.
+// sourceType is the type of the content source's dataset, if any.
+func selectProtocol(cfg *config.Configuration, requested string, capabilities []*csi.VolumeCapability, sourceType string) (string, error) {
+	supportsAll := func(protocol string) bool {
+		for _, capability := range capabilities {
+			if !protocolSupports(protocol, capability) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	switch requested {
+	case "", config.ProtocolAuto:
+		for _, protocol := range autoProtocols {
+			if protocolConfigured(cfg, protocol) && protocolAllowed(cfg, protocol) && supportsAll(protocol) && sourceTypeSupports(sourceType, protocol) {
+				return protocol, nil
+			}
+		}
+
+		if sourceType != "" {
+			return "", status.Error(codes.InvalidArgument, "No allowed protocol provides the requested VolumeCapabilities for the content source")
+		}
+
+		return "", status.Error(codes.InvalidArgument, "No allowed protocol provides the requested VolumeCapabilities")
+	case config.ProtocolNFS, config.ProtocolISCSI, config.ProtocolSMB:
+		if !protocolAllowed(cfg, requested) {
+			return "", status.Errorf(codes.InvalidArgument, "Protocol %q is not allowed by configuration", requested)
+		}
+		if !supportsAll(requested) {
+			return "", status.Errorf(codes.InvalidArgument, "Protocol %q does not provide the requested VolumeCapabilities", requested)
+		}
+		if !sourceTypeSupports(sourceType, requested) {
+			return "", status.Errorf(codes.InvalidArgument, "Protocol %q is incompatible with the content source", requested)
+		}
+
+		return requested, nil
+	}
+
+	return "", status.Errorf(codes.InvalidArgument, "Unsupported protocol: %q", requested)
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+)
+
+func capability(block bool, mode csi.VolumeCapability_AccessMode_Mode) *csi.VolumeCapability {
+	c := &csi.VolumeCapability{AccessMode: &csi.VolumeCapability_AccessMode{Mode: mode}}
+	if block {
+		c.AccessType = &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}
+	} else {
+		c.AccessType = &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}
+	}
+
+	return c
+}
+
+var (
+	blockSingle = capability(true, csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER)
+	mountSingle = capability(false, csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER)
+	mountMulti  = capability(false, csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER)
+)
+
+func TestProtocolSupports(t *testing.T) {
+	tests := []struct {
+		protocol   string
+		capability *csi.VolumeCapability
+		want       bool
+	}{
+		{config.ProtocolISCSI, blockSingle, true},
+		{config.ProtocolNFS, blockSingle, false},
+		{config.ProtocolSMB, blockSingle, false},
+		{config.ProtocolISCSI, mountSingle, true},
+		{config.ProtocolNFS, mountSingle, true},
+		{config.ProtocolISCSI, mountMulti, false},
+		{config.ProtocolNFS, mountMulti, true},
+		{config.ProtocolSMB, mountMulti, true},
+	}
+
+	for _, tt := range tests {
+		if got := protocolSupports(tt.protocol, tt.capability); got != tt.want {
+			t.Errorf("protocolSupports(%q, %v) = %v, want %v", tt.protocol, tt.capability, got, tt.want)
+		}
+	}
+}
+
+func TestSelectProtocol(t *testing.T) {
+	all := &config.Configuration{NFS: &config.NFS{}, ISCSI: &config.ISCSI{}, SMB: &config.SMB{}}
+	fsOnly := &config.Configuration{NFS: &config.NFS{}, SMB: &config.SMB{}}
+	smbAllowed := &config.Configuration{NFS: &config.NFS{}, ISCSI: &config.ISCSI{}, SMB: &config.SMB{}, AllowedProtocols: []string{config.ProtocolSMB}}
+
+	tests := []struct {
+		name         string
+		cfg          *config.Configuration
+		requested    string
+		capabilities []*csi.VolumeCapability
+		sourceType   string
+		want         string
+		wantErr      bool
+	}{
+		{"auto prefers iscsi", all, "", []*csi.VolumeCapability{mountSingle}, "", config.ProtocolISCSI, false},
+		{"auto multi-node", all, config.ProtocolAuto, []*csi.VolumeCapability{mountMulti}, "", config.ProtocolNFS, false},
+		{"auto mixed capabilities", all, "", []*csi.VolumeCapability{mountSingle, mountMulti}, "", config.ProtocolNFS, false},
+		{"auto unconfigured", fsOnly, "", []*csi.VolumeCapability{mountSingle}, "", config.ProtocolNFS, false},
+		{"auto block unconfigured", fsOnly, "", []*csi.VolumeCapability{blockSingle}, "", "", true},
+		{"auto allowed", smbAllowed, "", []*csi.VolumeCapability{mountSingle}, "", config.ProtocolSMB, false},
+		{"auto filesystem source", all, "", []*csi.VolumeCapability{mountSingle}, "FILESYSTEM", config.ProtocolNFS, false},
+		{"auto volume source", all, "", []*csi.VolumeCapability{mountMulti}, "VOLUME", "", true},
+		{"requested", all, config.ProtocolSMB, []*csi.VolumeCapability{mountSingle}, "", config.ProtocolSMB, false},
+		{"requested not allowed", smbAllowed, config.ProtocolNFS, []*csi.VolumeCapability{mountSingle}, "", "", true},
+		{"requested unsupported capability", all, config.ProtocolNFS, []*csi.VolumeCapability{blockSingle}, "", "", true},
+		{"requested incompatible source", all, config.ProtocolISCSI, []*csi.VolumeCapability{mountSingle}, "FILESYSTEM", "", true},
+		{"unknown", all, "ftp", []*csi.VolumeCapability{mountSingle}, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectProtocol(tt.cfg, tt.requested, tt.capabilities, tt.sourceType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectProtocol() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("selectProtocol() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
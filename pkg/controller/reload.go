@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+)
+
+// reloadCheckTimeout limits the time spent checking volumes of removed configurations
+const reloadCheckTimeout = time.Minute
+
+// WarnRemovedVolumes logs a warning for each NAS and root dataset of current missing from next,
+// which still holds volumes. Such volumes cannot be managed once next is in use.
+func WarnRemovedVolumes(current, next config.CSIConfiguration) {
+	ctx, cancel := context.WithTimeout(context.Background(), reloadCheckTimeout)
+	defer cancel()
+
+	cs := &server{}
+
+	for _, nasName := range current.Names() {
+		nas := current[nasName]
+		nextNas := next[nasName]
+
+		for _, configName := range nas.ConfigurationNames() {
+			cfg := nas.Configurations[configName]
+			if nextNas != nil && nextNas.GetConfigurationForRootDataset(cfg.Dataset) != nil {
+				continue
+			}
+
+			cl, err := newTruenasOapiClient(nas)
+			if err != nil {
+				log.Printf("Warning: cannot check volumes of removed root dataset %q on %q: %+v", cfg.Dataset, nasName, err)
+				continue
+			}
+
+			datasets, err := cs.listDatasets(ctx, cl, cfg.Dataset, 0, 0)
+			if err != nil {
+				log.Printf("Warning: cannot check volumes of removed root dataset %q on %q: %+v", cfg.Dataset, nasName, err)
+				continue
+			}
+
+			volumes := 0
+			for _, di := range datasets {
+				if !strings.Contains(di.Comments, retainedKey) {
+					volumes++
+				}
+			}
+			if volumes == 0 {
+				continue
+			}
+
+			if nextNas == nil {
+				log.Printf("Warning: removed NAS %q still has %d volumes under %q", nasName, volumes, cfg.Dataset)
+			} else {
+				log.Printf("Warning: removed root dataset %q on %q still has %d volumes", cfg.Dataset, nasName, volumes)
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+	"github.com/dravanet/truenas-csi/pkg/volumecontext"
+)
+
+func (cs *server) createSMBVolume(ctx context.Context, cl *TruenasOapi.Client, smb *config.SMB, reqName string, dataset string, shareName string) (
+	volumeContext *volumecontext.VolumeContext,
+	err error) {
+
+	sharePath := path.Join("/mnt", dataset)
+
+	share, err := cs.getSMBShareByComment(ctx, cl, reqName)
+	if err != nil {
+		return
+	}
+
+	if share == nil {
+		enabled := true
+		hostsallow := make([]interface{}, 0, len(smb.AllowedHosts))
+		for _, host := range smb.AllowedHosts {
+			hostsallow = append(hostsallow, host)
+		}
+
+		if _, err = handleNasResponse(cl.PostSharingSmb(ctx, TruenasOapi.SharingSmbCreate0{
+			Enabled:    &enabled,
+			Name:       &shareName,
+			Comment:    &reqName,
+			Path:       &sharePath,
+			Hostsallow: &hostsallow,
+		})); err != nil {
+			return nil, err
+		}
+	} else if share.Path != sharePath {
+		return nil, fmt.Errorf("share %q uses dataset %q (expected: %q)", reqName, share.Path, sharePath)
+	}
+
+	volumeContext = smbVolumeContext(smb, shareName)
+
+	return
+}
+
+// smbVolumeContext returns the volume context of an smb volume shared as shareName
+func smbVolumeContext(smb *config.SMB, shareName string) *volumecontext.VolumeContext {
+	return &volumecontext.VolumeContext{
+		Smb: &volumecontext.SMB{
+			Address: fmt.Sprintf("//%s/%s", smb.Server, shareName),
+		},
+	}
+}
+
+func (cs *server) deleteSMBVolume(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo) error {
+	// Lookup smb share
+	share, err := cs.getSMBShareByComment(ctx, cl, di.Comments)
+	if err != nil {
+		return err
+	}
+
+	if share != nil {
+		// Delete smb share
+		if _, err = handleNasResponse(cl.DeleteSharingSmbIdId(ctx, share.ID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isSMBVolume returns true if a filesystem volume is shared over smb
+func (cs *server) isSMBVolume(ctx context.Context, cl *TruenasOapi.Client, cfg *config.Configuration, di *datasetInfo) (bool, error) {
+	if cfg.SMB == nil || di.Type != "FILESYSTEM" {
+		return false, nil
+	}
+
+	share, err := cs.getSMBShareByComment(ctx, cl, di.Comments)
+
+	return share != nil, err
+}
+
+// checkSMBVolume returns a description of the problem with the smb share of a volume, or "" if it is healthy
+func (cs *server) checkSMBVolume(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo) (string, error) {
+	share, err := cs.getSMBShareByComment(ctx, cl, di.Comments)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case share == nil:
+		return "smb share is missing", nil
+	case share.Enabled != nil && !*share.Enabled:
+		return "smb share is disabled", nil
+	}
+
+	return "", nil
+}
+
+type smbShare struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Comment *string `json:"comment"`
+	Path    string  `json:"path"`
+	Enabled *bool   `json:"enabled"`
+}
+
+func (cs *server) getSMBShareByComment(ctx context.Context, cl *TruenasOapi.Client, comment string) (share *smbShare, err error) {
+	var smbshareresp []byte
+	if smbshareresp, err = handleNasResponse(cl.GetSharingSmb(ctx, &TruenasOapi.GetSharingSmbParams{}, truenasOapiFilter("comment", comment))); err != nil {
+		return
+	}
+	var shares []smbShare
+	if err = json.Unmarshal(smbshareresp, &shares); err != nil {
+		return nil, status.Error(codes.Unavailable, "Error parsing NAS response")
+	}
+	if len(shares) > 1 {
+		return nil, status.Errorf(codes.Unavailable, "Unexpected data returned from NAS, multiple items have same comment: %+v", shares)
+	}
+	if len(shares) == 1 {
+		if shares[0].Comment == nil || *shares[0].Comment != comment {
+			return nil, status.Errorf(codes.Unavailable, "Unexpected data returned from NAS, seems like filtering does not work: %+v", shares)
+		}
+
+		share = &shares[0]
+	}
+
+	return
+}
@@ -0,0 +1,571 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+	TruenasOapi "github.com/dravanet/truenas-csi/pkg/truenas"
+)
+
+const (
+	// ZFS user properties set on snapshots created by the driver
+	snapshotNameProperty = "truenas-csi:name"
+	snapshotSizeProperty = "truenas-csi:size"
+	// snapshotGroupProperty holds the member datasets of a group snapshot
+	snapshotGroupProperty = "truenas-csi:group"
+	// snapshotSourceProperty holds the dataset a snapshot was taken of, which differs
+	// from its dataset once promoting a clone moved the snapshot to the clone
+	snapshotSourceProperty = "truenas-csi:source"
+	// snapshotTemporaryProperty marks snapshots taken for cloning volumes
+	snapshotTemporaryProperty = "truenas-csi:temporary"
+)
+
+func (cs *server) CreateSnapshot(ctx context.Context, req *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No name specified")
+	}
+
+	// from here req is not null
+
+	if req.SourceVolumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "No SourceVolumeId specified")
+	}
+
+	nases := cs.config.Load()
+
+	nas, dataset, err := parsevolumeid(nases, req.SourceVolumeId)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	di, err := cs.getDataset(ctx, cl, dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil {
+		return nil, status.Errorf(codes.NotFound, "Volume %q does not exist", req.SourceVolumeId)
+	}
+
+	snapshotName := datasetFromReqName(req.Name)
+
+	// Lookup existing snapshot with the same name
+	existing, err := cs.listSnapshots(ctx, cl, truenasOapiFilter("snapshot_name", snapshotName))
+	if err != nil {
+		return nil, err
+	}
+
+	var snap *snapshotInfo
+	for _, s := range existing {
+		if s.Source != dataset || s.Name != req.Name {
+			return nil, status.Errorf(codes.AlreadyExists, "snapshot %q already exists for a different source", req.Name)
+		}
+
+		snap = s
+	}
+
+	if snap == nil {
+		if snap, err = cs.createSnapshot(ctx, cl, di, req.Name, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return &csi.CreateSnapshotResponse{
+		Snapshot: snap.toCSI(nas),
+	}, nil
+}
+
+func (cs *server) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
+	if req.GetSnapshotId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No SnapshotId specified")
+	}
+
+	// from here req is not null
+
+	nases := cs.config.Load()
+
+	nas, snapshot, err := parsesnapshotid(nases, req.SnapshotId)
+	if status.Code(err) == codes.NotFound {
+		return &csi.DeleteSnapshotResponse{}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot delete snapshot %q: %+v", req.SnapshotId, err)
+	}
+
+	cl, err := newTruenasOapiClient(nas)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+	}
+
+	snap, err := cs.resolveSnapshot(ctx, cl, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if snap != nil {
+		if err = cs.deleteSnapshot(ctx, cl, snap.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &csi.DeleteSnapshotResponse{}, nil
+}
+
+func (cs *server) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
+	var start int
+	if req.GetStartingToken() != "" {
+		var err error
+		if start, err = strconv.Atoi(req.StartingToken); err != nil || start < 0 {
+			return nil, status.Errorf(codes.Aborted, "Invalid starting token: %q", req.StartingToken)
+		}
+	}
+
+	nases := cs.config.Load()
+	var entries []*csi.ListSnapshotsResponse_Entry
+
+	switch {
+	case req.GetSnapshotId() != "":
+		nas, snapshot, err := parsesnapshotid(nases, req.SnapshotId)
+		if err != nil {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+
+		if req.SourceVolumeId != "" && !strings.HasPrefix(req.SnapshotId, req.SourceVolumeId+"@") {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+
+		cl, err := newTruenasOapiClient(nas)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+		}
+
+		snap, err := cs.resolveSnapshot(ctx, cl, snapshot)
+		if err != nil {
+			return nil, err
+		}
+
+		if snap != nil && !snap.DeferDestroy && !snap.Temporary {
+			entries = append(entries, &csi.ListSnapshotsResponse_Entry{Snapshot: snap.toCSI(nas)})
+		}
+
+	case req.GetSourceVolumeId() != "":
+		nas, dataset, err := parsevolumeid(nases, req.SourceVolumeId)
+		if err != nil {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+
+		cl, err := newTruenasOapiClient(nas)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "creating FreenasOapi client failed")
+		}
+
+		snaps, err := cs.listSnapshots(ctx, cl, truenasOapiFilter("dataset", dataset))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snap := range snaps {
+			if snap.DeferDestroy || snap.Temporary {
+				continue
+			}
+
+			entries = append(entries, &csi.ListSnapshotsResponse_Entry{Snapshot: snap.toCSI(nas)})
+		}
+
+	default:
+		for _, nasName := range nases.Names() {
+			nas := nases[nasName]
+
+			cl, err := newTruenasOapiClient(nas)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "creating TruenasOapi client failed for %q", nasName)
+			}
+
+			snaps, err := cs.listSnapshots(ctx, cl)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, snap := range snaps {
+				// Only report snapshots of volumes managed by the driver
+				if snap.DeferDestroy || snap.Temporary || nas.GetConfigurationForRootDataset(path.Dir(snap.Source)) == nil {
+					continue
+				}
+
+				entries = append(entries, &csi.ListSnapshotsResponse_Entry{Snapshot: snap.toCSI(nas)})
+			}
+		}
+	}
+
+	if start > len(entries) {
+		return nil, status.Errorf(codes.Aborted, "Invalid starting token: %q", req.StartingToken)
+	}
+
+	entries = entries[start:]
+
+	var nextToken string
+	if req.MaxEntries > 0 && int(req.MaxEntries) < len(entries) {
+		entries = entries[:req.MaxEntries]
+		nextToken = strconv.Itoa(start + int(req.MaxEntries))
+	}
+
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+type snapshotInfo struct {
+	ID      string
+	Dataset string
+	// Source is the dataset the snapshot was taken of, snapshot ids refer to it
+	Source       string
+	Name         string
+	SizeBytes    int64
+	CreationTime int64
+	Clones       []string
+
+	// Group lists member datasets if the snapshot is part of a group snapshot
+	Group []string
+
+	// DeferDestroy is set on snapshots already deleted, but kept until their clones exist
+	DeferDestroy bool
+
+	// Temporary is set on snapshots taken for cloning volumes
+	Temporary bool
+}
+
+func (snap *snapshotInfo) toCSI(nas *config.FreeNAS) *csi.Snapshot {
+	result := &csi.Snapshot{
+		SnapshotId:     fmt.Sprintf("%s:%s@%s", nas.Name(), snap.Source, snap.snapshotName()),
+		SourceVolumeId: fmt.Sprintf("%s:%s", nas.Name(), snap.Source),
+		SizeBytes:      snap.SizeBytes,
+		CreationTime:   timestamppb.New(time.Unix(snap.CreationTime, 0)),
+		ReadyToUse:     true,
+	}
+	if len(snap.Group) > 0 {
+		result.GroupSnapshotId = fmt.Sprintf("%s:%s", nas.Name(), snap.snapshotName())
+	}
+
+	return result
+}
+
+// snapshotName returns the name of the snapshot without its dataset
+func (snap *snapshotInfo) snapshotName() string {
+	return strings.TrimPrefix(snap.ID, snap.Dataset+"@")
+}
+
+type zfsProperty struct {
+	Rawvalue string `json:"rawvalue"`
+}
+
+type zfsSnapshot struct {
+	ID         string                 `json:"id"`
+	Dataset    string                 `json:"dataset"`
+	Properties map[string]zfsProperty `json:"properties"`
+}
+
+func (zs *zfsSnapshot) toSnapshotInfo() *snapshotInfo {
+	snap := &snapshotInfo{
+		ID:        zs.ID,
+		Dataset:   zs.Dataset,
+		Source:    zs.Properties[snapshotSourceProperty].Rawvalue,
+		Name:      zs.Properties[snapshotNameProperty].Rawvalue,
+		Temporary: zs.Properties[snapshotTemporaryProperty].Rawvalue == "true",
+	}
+	if snap.Source == "" {
+		snap.Source = zs.Dataset
+	}
+
+	snap.CreationTime, _ = strconv.ParseInt(zs.Properties["creation"].Rawvalue, 10, 64)
+	snap.DeferDestroy = zs.Properties["defer_destroy"].Rawvalue == "on"
+
+	if clones := zs.Properties["clones"].Rawvalue; clones != "" {
+		snap.Clones = strings.Split(clones, ",")
+	}
+	if group := zs.Properties[snapshotGroupProperty].Rawvalue; group != "" {
+		snap.Group = strings.Split(group, ",")
+	}
+
+	// Prefer the source volume's capacity recorded at snapshot creation,
+	// group snapshots record it per member
+	for _, prop := range []string{snapshotSizeProperty, snapshotSizeProperty + ":" + path.Base(zs.Dataset), "volsize", "referenced"} {
+		if size, err := strconv.ParseInt(zs.Properties[prop].Rawvalue, 10, 64); err == nil && size > 0 {
+			snap.SizeBytes = size
+			break
+		}
+	}
+
+	return snap
+}
+
+func (cs *server) getSnapshot(ctx context.Context, cl *TruenasOapi.Client, snapshot string) (*snapshotInfo, error) {
+	resp, err := cl.GetZfsSnapshotIdId(ctx, snapshot, &TruenasOapi.GetZfsSnapshotIdIdParams{})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error during call to Nas: %+v", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error reading response body: %+v", err)
+	}
+	_ = resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200:
+		var result zfsSnapshot
+		if err = json.Unmarshal(body, &result); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Error parsing snapshot from NAS: %+v", err)
+		}
+
+		return result.toSnapshotInfo(), nil
+	case 404:
+		return nil, nil
+	}
+
+	return nil, status.Errorf(codes.Unavailable, "Unexpected result from Nas: %s", string(body))
+}
+
+// createSnapshot creates a snapshot of a dataset named after reqName, or returns the existing one.
+// temporary marks snapshots taken for cloning volumes.
+func (cs *server) createSnapshot(ctx context.Context, cl *TruenasOapi.Client, di *datasetInfo, reqName string, temporary bool) (*snapshotInfo, error) {
+	snapshotName := datasetFromReqName(reqName)
+	snapshot := fmt.Sprintf("%s@%s", di.ID, snapshotName)
+
+	snap, err := cs.getSnapshot(ctx, cl, snapshot)
+	if err != nil || snap != nil {
+		return snap, err
+	}
+
+	var size int64
+	switch {
+	case di.Volsize != nil:
+		size = *di.Volsize
+	case di.Refquota != nil:
+		size = *di.Refquota
+	}
+
+	properties := map[string]interface{}{
+		snapshotNameProperty:   reqName,
+		snapshotSizeProperty:   strconv.FormatInt(size, 10),
+		snapshotSourceProperty: di.ID,
+	}
+	if temporary {
+		properties[snapshotTemporaryProperty] = "true"
+	}
+
+	if _, err = handleNasResponse(cl.PostZfsSnapshot(ctx, TruenasOapi.ZfsSnapshotCreate0{
+		Dataset:    &di.ID,
+		Name:       &snapshotName,
+		Properties: &properties,
+	})); err != nil {
+		return nil, err
+	}
+
+	if snap, err = cs.getSnapshot(ctx, cl, snapshot); err != nil {
+		return nil, err
+	}
+	if snap == nil {
+		return nil, status.Errorf(codes.Unavailable, "snapshot for %q not found after creation", reqName)
+	}
+
+	return snap, nil
+}
+
+// deleteSnapshot deletes a snapshot. Destroy is deferred, so that snapshots
+// having clones are removed once their clones are gone.
+func (cs *server) deleteSnapshot(ctx context.Context, cl *TruenasOapi.Client, snapshot string) error {
+	deferDestroy := true
+	resp, err := cl.DeleteZfsSnapshotIdId(ctx, snapshot, TruenasOapi.ZfsSnapshotDelete1{Defer: &deferDestroy})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "Error during call to Nas: %+v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200, 404:
+	default:
+		return status.Errorf(codes.Unavailable, "Unexpected result from Nas: %s", string(body))
+	}
+
+	return nil
+}
+
+// promoteClones promotes all clones of dataset's snapshots, so that dataset can be destroyed
+func (cs *server) promoteClones(ctx context.Context, cl *TruenasOapi.Client, dataset string) error {
+	snaps, err := cs.listSnapshots(ctx, cl, truenasOapiFilter("dataset", dataset))
+	if err != nil {
+		return err
+	}
+
+	for _, snap := range snaps {
+		for _, clone := range snap.Clones {
+			if _, err = handleNasResponse(cl.PostPoolDatasetIdIdPromote(ctx, clone, TruenasOapi.PoolDatasetPromote{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// listSnapshots returns snapshots matching filters, ordered by their id
+func (cs *server) listSnapshots(ctx context.Context, cl *TruenasOapi.Client, filters ...TruenasOapi.RequestEditorFn) ([]*snapshotInfo, error) {
+	sortBy := "id"
+
+	body, err := handleNasResponse(cl.GetZfsSnapshot(ctx, &TruenasOapi.GetZfsSnapshotParams{Sort: &sortBy}, filters...))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []zfsSnapshot
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error parsing snapshots from NAS: %+v", err)
+	}
+
+	snaps := make([]*snapshotInfo, 0, len(result))
+	groups := make(map[string][]*snapshotInfo)
+	for i := range result {
+		snap := result[i].toSnapshotInfo()
+		snaps = append(snaps, snap)
+
+		if len(snap.Group) > 0 && !snap.DeferDestroy {
+			groups[snap.snapshotName()] = append(groups[snap.snapshotName()], snap)
+		}
+	}
+
+	for _, group := range groups {
+		resolveGroupSources(group)
+	}
+
+	return snaps, nil
+}
+
+// resolveGroupSources sets the source of a group snapshot's member moved by a promotion.
+// Members of a recursive snapshot share their properties, so a moved member is recognized
+// by elimination: it is resolved only if it is the single moved one among snaps.
+func resolveGroupSources(snaps []*snapshotInfo) {
+	var moved []*snapshotInfo
+	inPlace := make(map[string]bool, len(snaps))
+	for _, snap := range snaps {
+		if slices.Contains(snap.Group, snap.Dataset) {
+			inPlace[snap.Dataset] = true
+		} else {
+			moved = append(moved, snap)
+		}
+	}
+
+	var missing []string
+	for _, dataset := range snaps[0].Group {
+		if !inPlace[dataset] {
+			missing = append(missing, dataset)
+		}
+	}
+
+	if len(moved) == 1 && len(missing) == 1 {
+		moved[0].Source = missing[0]
+	}
+}
+
+// resolveSnapshot returns a snapshot by its id. Promoting a clone moves earlier snapshots of
+// the clone's origin to the clone, these are looked up by their name and source.
+func (cs *server) resolveSnapshot(ctx context.Context, cl *TruenasOapi.Client, snapshot string) (*snapshotInfo, error) {
+	snap, err := cs.getSnapshot(ctx, cl, snapshot)
+	if err != nil || snap != nil {
+		return snap, err
+	}
+
+	dataset, name, _ := strings.Cut(snapshot, "@")
+
+	snaps, err := cs.listSnapshots(ctx, cl, truenasOapiFilter("snapshot_name", name))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snap := range snaps {
+		if snap.Source == dataset && snap.Dataset != dataset && snap.Name != "" {
+			return snap, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getSourceSnapshot looks up a snapshot to be used as volume content source on nas
+func (cs *server) getSourceSnapshot(ctx context.Context, cl *TruenasOapi.Client, nases config.CSIConfiguration, nas *config.FreeNAS, snapshotid string) (*snapshotInfo, error) {
+	snapNas, snapshot, err := parsesnapshotid(nases, snapshotid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Snapshot %q not found: %+v", snapshotid, err)
+	}
+
+	if snapNas != nas {
+		return nil, status.Errorf(codes.InvalidArgument, "Snapshot %q resides on a different NAS", snapshotid)
+	}
+
+	snap, err := cs.resolveSnapshot(ctx, cl, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil || snap.DeferDestroy {
+		return nil, status.Errorf(codes.NotFound, "Snapshot %q not found", snapshotid)
+	}
+
+	return snap, nil
+}
+
+// snapshotSourceVolume takes a temporary snapshot of a volume to be cloned on nas
+func (cs *server) snapshotSourceVolume(ctx context.Context, cl *TruenasOapi.Client, nases config.CSIConfiguration, nas *config.FreeNAS, volumeid string, reqName string) (*snapshotInfo, error) {
+	volNas, dataset, err := parsevolumeid(nases, volumeid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Volume %q not found: %+v", volumeid, err)
+	}
+
+	if volNas != nas {
+		return nil, status.Errorf(codes.InvalidArgument, "Volume %q resides on a different NAS", volumeid)
+	}
+
+	di, err := cs.getDataset(ctx, cl, dataset)
+	if err != nil {
+		return nil, err
+	}
+	if di == nil {
+		return nil, status.Errorf(codes.NotFound, "Volume %q not found", volumeid)
+	}
+
+	return cs.createSnapshot(ctx, cl, di, reqName, true)
+}
+
+func parsesnapshotid(nases config.CSIConfiguration, snapshotid string) (nas *config.FreeNAS, snapshot string, err error) {
+	var dataset string
+	if nas, dataset, err = parsevolumeid(nases, snapshotid); err != nil {
+		return
+	}
+
+	parts := strings.SplitN(dataset, "@", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = status.Errorf(codes.NotFound, "Invalid SnapshotId received: %s", snapshotid)
+		return
+	}
+
+	snapshot = dataset
+
+	return
+}
@@ -34,11 +34,12 @@ func (is *server) Probe(ctx context.Context, req *csi.ProbeRequest) (*csi.ProbeR
 	return &csi.ProbeResponse{}, nil
 }
 
-// New returns a new csi.IdentityServer
-func New(controller bool) csi.IdentityServer {
+// New returns a new csi.IdentityServer. Accessibility constraints are advertised
+// only if topology is used, as COs require topology on all nodes then.
+func New(controller bool, topology bool) csi.IdentityServer {
 	var caps []*csi.PluginCapability
 
-	if controller { // advertise controller service
+	if controller { // advertise controller services
 		caps = append(caps, &csi.PluginCapability{
 			Type: &csi.PluginCapability_Service_{
 				Service: &csi.PluginCapability_Service{
@@ -46,6 +47,24 @@ func New(controller bool) csi.IdentityServer {
 				},
 			},
 		})
+
+		caps = append(caps, &csi.PluginCapability{
+			Type: &csi.PluginCapability_Service_{
+				Service: &csi.PluginCapability_Service{
+					Type: csi.PluginCapability_Service_GROUP_CONTROLLER_SERVICE,
+				},
+			},
+		})
+	}
+
+	if topology {
+		caps = append(caps, &csi.PluginCapability{
+			Type: &csi.PluginCapability_Service_{
+				Service: &csi.PluginCapability_Service{
+					Type: csi.PluginCapability_Service_VOLUME_ACCESSIBILITY_CONSTRAINTS,
+				},
+			},
+		})
 	}
 
 	caps = append(caps, &csi.PluginCapability{
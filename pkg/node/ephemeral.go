@@ -0,0 +1,216 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/config"
+	"github.com/dravanet/truenas-csi/pkg/csi"
+)
+
+// ephemeralContextKey is set in the volume context of inline volumes by kubelet
+const ephemeralContextKey = "csi.storage.k8s.io/ephemeral"
+
+// ephemeralSizeAttribute holds the size of an inline volume, e.g. 10Gi
+const ephemeralSizeAttribute = config.PropertyPrefix + "size"
+
+var sizeRe = regexp.MustCompile(`^([0-9]+)([KMGT]i?)?$`)
+
+// ephemeralVolume is the state of an inline volume, kept for NodeUnpublishVolume
+type ephemeralVolume struct {
+	VolumeID string `json:"volumeId"`
+}
+
+// isEphemeral returns true if a publish request is for an inline volume
+func isEphemeral(req *csi.NodePublishVolumeRequest) bool {
+	return req.VolumeContext[ephemeralContextKey] == "true"
+}
+
+// ephemeralVolumeDir returns the directory holding state and staging path of an inline volume
+func (ns *server) ephemeralVolumeDir(volumeID string) string {
+	return path.Join(ns.ephemeralDir, volumeID)
+}
+
+// publishEphemeralVolume provisions an inline volume through the node's own controller,
+// then stages and publishes it
+func (ns *server) publishEphemeralVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
+	if ns.provisioner == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Ephemeral volumes are not enabled on this node")
+	}
+
+	if strings.ContainsRune(req.VolumeId, '/') {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid VolumeId: %q", req.VolumeId)
+	}
+
+	createReq := &csi.CreateVolumeRequest{
+		Name:               req.VolumeId,
+		VolumeCapabilities: []*csi.VolumeCapability{req.VolumeCapability},
+		Parameters:         map[string]string{},
+		Secrets:            req.Secrets,
+		// Inline volumes are sparse unless requested otherwise
+		MutableParameters: map[string]string{config.PropertyPrefix + "sparse": "true"},
+	}
+
+	for key, value := range req.VolumeContext {
+		switch {
+		case key == ephemeralSizeAttribute:
+			size, err := ParseSize(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid value for %s: %q", key, value)
+			}
+			if ns.ephemeralMaxSize > 0 && size > ns.ephemeralMaxSize {
+				return nil, status.Errorf(codes.OutOfRange, "Requested size of %d bytes exceeds the maximum of inline volumes: %d bytes", size, ns.ephemeralMaxSize)
+			}
+			createReq.CapacityRange = &csi.CapacityRange{RequiredBytes: size, LimitBytes: size}
+		case !strings.HasPrefix(key, config.PropertyPrefix):
+			continue
+		case !slices.Contains(ns.ephemeralAttributes, key):
+			// Pod authors must not choose e.g. the nas, networks or ownership
+			return nil, status.Errorf(codes.InvalidArgument, "Volume attribute %s is not allowed for inline volumes", key)
+		case key == config.PropertyPrefix+"sparse", key == config.PropertyPrefix+"allowedNetworks":
+			createReq.MutableParameters[key] = value
+		default:
+			createReq.Parameters[key] = value
+		}
+	}
+
+	if len(ns.topology) > 0 {
+		createReq.AccessibilityRequirements = &csi.TopologyRequirement{
+			Requisite: []*csi.Topology{{Segments: ns.topology}},
+		}
+	}
+
+	dir := ns.ephemeralVolumeDir(req.VolumeId)
+	stagingPath := path.Join(dir, "staging")
+	if err := os.MkdirAll(stagingPath, 0o750); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error creating ephemeral volume directory: %+v", err)
+	}
+
+	createResp, err := ns.provisioner.CreateVolume(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+	volume := createResp.Volume
+
+	// State is saved first, so that unpublish cleans up after a partial publish
+	state, _ := json.Marshal(&ephemeralVolume{VolumeID: volume.VolumeId})
+	if err = os.WriteFile(path.Join(dir, "volume"), state, 0o640); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error writing ephemeral volume state: %+v", err)
+	}
+
+	publishResp, err := ns.provisioner.ControllerPublishVolume(ctx, &csi.ControllerPublishVolumeRequest{
+		VolumeId:         volume.VolumeId,
+		NodeId:           ns.nodeId,
+		VolumeCapability: req.VolumeCapability,
+		Readonly:         req.Readonly,
+		Secrets:          req.Secrets,
+		VolumeContext:    volume.VolumeContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = ns.NodeStageVolume(ctx, &csi.NodeStageVolumeRequest{
+		VolumeId:          volume.VolumeId,
+		PublishContext:    publishResp.PublishContext,
+		StagingTargetPath: stagingPath,
+		VolumeCapability:  req.VolumeCapability,
+		Secrets:           req.Secrets,
+		VolumeContext:     volume.VolumeContext,
+	}); err != nil {
+		return nil, err
+	}
+
+	return ns.NodePublishVolume(ctx, &csi.NodePublishVolumeRequest{
+		VolumeId:          volume.VolumeId,
+		PublishContext:    publishResp.PublishContext,
+		StagingTargetPath: stagingPath,
+		TargetPath:        req.TargetPath,
+		VolumeCapability:  req.VolumeCapability,
+		Readonly:          req.Readonly,
+		Secrets:           req.Secrets,
+		VolumeContext:     volume.VolumeContext,
+	})
+}
+
+// unpublishEphemeralVolume unstages and destroys an inline volume, if volumeID refers to one
+func (ns *server) unpublishEphemeralVolume(ctx context.Context, volumeID string) error {
+	if ns.provisioner == nil || strings.ContainsRune(volumeID, '/') {
+		return nil
+	}
+
+	dir := ns.ephemeralVolumeDir(volumeID)
+	stateb, err := os.ReadFile(path.Join(dir, "volume"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "Error reading ephemeral volume state: %+v", err)
+	}
+
+	var state ephemeralVolume
+	if err = json.Unmarshal(stateb, &state); err != nil {
+		return status.Errorf(codes.Unavailable, "Error parsing ephemeral volume state: %+v", err)
+	}
+
+	if _, err = ns.NodeUnstageVolume(ctx, &csi.NodeUnstageVolumeRequest{
+		VolumeId:          state.VolumeID,
+		StagingTargetPath: path.Join(dir, "staging"),
+	}); err != nil {
+		return err
+	}
+
+	if _, err = ns.provisioner.ControllerUnpublishVolume(ctx, &csi.ControllerUnpublishVolumeRequest{
+		VolumeId: state.VolumeID,
+		NodeId:   ns.nodeId,
+	}); err != nil {
+		return err
+	}
+
+	if _, err = ns.provisioner.DeleteVolume(ctx, &csi.DeleteVolumeRequest{
+		VolumeId: state.VolumeID,
+	}); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// ParseSize parses a size in bytes, with optional K, M, G, T suffix in decimal or binary (Ki, ...) units
+func ParseSize(value string) (int64, error) {
+	m := sizeRe.FindStringSubmatch(value)
+	if m == nil {
+		return 0, strconv.ErrSyntax
+	}
+
+	size, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if m[2] != "" {
+		base := int64(1000)
+		if strings.HasSuffix(m[2], "i") {
+			base = 1024
+		}
+
+		for range strings.Index("KMGT", m[2][:1]) + 1 {
+			if size > math.MaxInt64/base {
+				return 0, strconv.ErrRange
+			}
+			size *= base
+		}
+	}
+
+	return size, nil
+}
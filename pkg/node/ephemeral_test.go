@@ -0,0 +1,38 @@
+package node
+
+import "testing"
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"1024", 1024, false},
+		{"1K", 1000, false},
+		{"1Ki", 1024, false},
+		{"10Gi", 10 << 30, false},
+		{"2T", 2_000_000_000_000, false},
+		{"1Ti", 1 << 40, false},
+		{"8388607Ti", 8388607 << 40, false},
+		{"8388608Ti", 0, true},
+		{"99999999999999999999", 0, true},
+		{"", 0, true},
+		{"-1", 0, true},
+		{"1.5Gi", 0, true},
+		{"1Pi", 0, true},
+		{"1gi", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSize(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSize(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSize(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
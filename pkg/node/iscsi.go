@@ -2,14 +2,18 @@ package node
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"golang.org/x/sys/unix"
@@ -57,21 +61,52 @@ const (
 	ISCSI_ERR_SESSION_NOT_CONNECTED  = 32
 )
 
+// iscsiTargetMu serializes staging and unstaging of iscsi volumes, so that
+// sessions are not logged out while another lun of the target is being staged
+var iscsiTargetMu sync.Mutex
+
 func (ns *server) stageISCSIVolume(ctx context.Context, req *csi.NodeStageVolumeRequest, iscsi *volumecontext.ISCSI) (err error) {
-	portal := iscsi.Portal
-	if !strings.Contains(portal, ":") {
-		portal = portal + ":3260"
+	iscsiTargetMu.Lock()
+	defer iscsiTargetMu.Unlock()
+
+	// Each portal provides a path to the volume
+	portals := iscsiPortals(iscsi)
+	paths := make([]string, len(portals))
+	for i, portal := range portals {
+		paths[i] = fmt.Sprintf("/dev/disk/by-path/ip-%s-iscsi-%s-lun-%d", portal, iscsi.Target, iscsi.Lun)
+	}
+
+	for _, device := range paths {
+		if _, err = os.Stat(device); err != nil {
+			break
+		}
 	}
 
-	device := fmt.Sprintf("/dev/disk/by-path/ip-%s-iscsi-%s-lun-0", portal, iscsi.Target)
-	_, err = os.Stat(device)
+	// The lun is recorded before login, so that the session is kept for it. Records
+	// of luns already staged are kept on failures of a repeated stage.
+	if !ns.iscsiLunRecorded(iscsi.Target, iscsi.Lun) {
+		if err := ns.iscsiRecordLun(iscsi.Target, iscsi.Lun); err != nil {
+			return status.Errorf(codes.Unavailable, "Error recording staged lun: %+v", err)
+		}
+		defer func() {
+			if err != nil {
+				ns.iscsiForgetLun(iscsi.Target, iscsi.Lun)
+			}
+		}()
+	}
 
 	if err != nil {
+		if iscsi.DiscoveryAuth {
+			if err = iscsiDiscover(ctx, iscsi, req.Secrets); err != nil {
+				return status.Errorf(codes.Unavailable, "failed discovering iscsi targets: %+v", err)
+			}
+		}
+
 		if err = iscsiAddNode(ctx, iscsi); err != nil {
 			return status.Errorf(codes.Unavailable, "failed adding iscsi node: %+v", err)
 		}
 		defer func() {
-			if err != nil {
+			if err != nil && !ns.iscsiTargetInUse(iscsi.Target, iscsi.Lun) {
 				iscsiDeleteNode(context.Background(), iscsi.Target)
 			}
 		}()
@@ -80,13 +115,27 @@ func (ns *server) stageISCSIVolume(ctx context.Context, req *csi.NodeStageVolume
 			return status.Errorf(codes.Unavailable, "failed logging into iscsi target: %+v", err)
 		}
 		defer func() {
-			if err != nil {
+			if err != nil && !ns.iscsiTargetInUse(iscsi.Target, iscsi.Lun) {
 				iscsiLogoutNode(context.Background(), iscsi.Target)
 			}
 		}()
 
-		if err = iscsiWaitForDevice(ctx, device); err != nil {
-			return status.Errorf(codes.Unavailable, "Error waiting for device: %+v", err)
+		// Luns added to a target with an existing session are discovered by a rescan
+		if err = iscsiRescanNode(ctx, iscsi.Target); err != nil {
+			return status.Errorf(codes.Unavailable, "failed rescanning iscsi target: %+v", err)
+		}
+
+		for _, device := range paths {
+			if err = iscsiWaitForDevice(ctx, device); err != nil {
+				return status.Errorf(codes.Unavailable, "Error waiting for device: %+v", err)
+			}
+		}
+	}
+
+	device := paths[0]
+	if len(paths) > 1 {
+		if device, err = iscsiWaitForMultipathDevice(ctx, device); err != nil {
+			return status.Errorf(codes.Unavailable, "Error waiting for multipath device: %+v", err)
 		}
 	}
 
@@ -94,6 +143,10 @@ func (ns *server) stageISCSIVolume(ctx context.Context, req *csi.NodeStageVolume
 		return status.Errorf(codes.Unavailable, "Error writing to staging/iscsi: %+v", err)
 	}
 
+	if err = os.WriteFile(path.Join(req.StagingTargetPath, "lun"), []byte(strconv.Itoa(iscsi.Lun)), 0o640); err != nil {
+		return status.Errorf(codes.Unavailable, "Error writing to staging/lun: %+v", err)
+	}
+
 	devicePath := path.Join(req.StagingTargetPath, "device")
 	os.Remove(devicePath)
 	if err = os.Symlink(device, devicePath); err != nil {
@@ -104,14 +157,7 @@ func (ns *server) stageISCSIVolume(ctx context.Context, req *csi.NodeStageVolume
 		blkidErr := execCmd(ctx, "blkid", "-p", devicePath)
 		if blkidErr != nil {
 			if exitError, ok := blkidErr.(*exec.ExitError); ok && exitError.ExitCode() == 2 {
-				fsType := mount.FsType
-				switch fsType {
-				case "ext3", "ext4", "xfs":
-				default:
-					fsType = "ext4"
-				}
-
-				mkfsErr := execCmd(ctx, fmt.Sprintf("mkfs.%s", fsType), devicePath)
+				mkfsErr := execCmd(ctx, fmt.Sprintf("mkfs.%s", iscsiFsType(mount)), devicePath)
 				if mkfsErr != nil {
 					return status.Errorf(codes.Unavailable, "Error creating filesystem: %+v", mkfsErr)
 				}
@@ -119,17 +165,174 @@ func (ns *server) stageISCSIVolume(ctx context.Context, req *csi.NodeStageVolume
 				return status.Errorf(codes.Unavailable, "Error determining existing filesystem: %+v", blkidErr)
 			}
 		}
+
+		// Filesystems of read-only volumes are not modified
+		if group := mount.VolumeMountGroup; group != "" && !isReaderOnly(req.VolumeCapability) {
+			if err = iscsiApplyMountGroup(ctx, req.StagingTargetPath, devicePath, group); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// iscsiApplyMountGroup makes the root of the filesystem on device writable by group,
+// with setgid so that new files inherit the group. Roots already owned by group are
+// left as they are, keeping changes made by users.
+func iscsiApplyMountGroup(ctx context.Context, stagingPath string, device string, group string) error {
+	gid, err := strconv.Atoi(group)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid volume mount group: %q", group)
+	}
+
+	mnt := path.Join(stagingPath, "mnt")
+	os.Mkdir(mnt, 0o750)
+	defer os.Remove(mnt)
+
+	if err = execCmd(ctx, "mount", device, mnt); err != nil {
+		return status.Errorf(codes.Unavailable, "Error mounting filesystem: %+v", err)
+	}
+	defer umount(context.Background(), mnt)
+
+	info, err := os.Stat(mnt)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "Error reading filesystem root: %+v", err)
+	}
+
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok && int(stat.Gid) == gid {
+		return nil
+	}
+
+	if err = os.Chown(mnt, -1, gid); err != nil {
+		return status.Errorf(codes.Unavailable, "Error changing group of filesystem root: %+v", err)
+	}
+
+	if err = os.Chmod(mnt, info.Mode().Perm()|0o070|os.ModeSetgid); err != nil {
+		return status.Errorf(codes.Unavailable, "Error changing mode of filesystem root: %+v", err)
 	}
 
 	return nil
 }
 
-func (ns *server) unstageISCSIVolume(ctx context.Context, target string) (err error) {
+// iscsiFsType returns the filesystem type of a mount capability, ext4 if unsupported
+func iscsiFsType(mount *csi.VolumeCapability_MountVolume) string {
+	switch fsType := mount.GetFsType(); fsType {
+	case "ext3", "ext4", "xfs":
+		return fsType
+	}
+
+	return "ext4"
+}
+
+// iscsiPortals returns the portals of a volume with port numbers
+func iscsiPortals(iscsi *volumecontext.ISCSI) []string {
+	portals := append([]string{iscsi.Portal}, iscsi.Portals...)
+	for i, portal := range portals {
+		if !strings.Contains(portal, ":") {
+			portals[i] = portal + ":3260"
+		}
+	}
+
+	return portals
+}
+
+func (ns *server) unstageISCSIVolume(ctx context.Context, target string, lun int, device string) (err error) {
+	iscsiTargetMu.Lock()
+	defer iscsiTargetMu.Unlock()
+
+	pathDevices := iscsiPathDevices(device)
+
+	// Multipath maps are flushed before their paths disappear, unless flushed by an earlier attempt
+	if name, ok := strings.CutPrefix(device, "/dev/mapper/"); ok && len(pathDevices) > 0 {
+		if err = execCmd(ctx, "multipath", "-f", name); err != nil {
+			return
+		}
+	}
+
+	// Sessions are kept while other luns of the target are staged
+	if ns.iscsiTargetInUse(target, lun) {
+		for _, blockdevice := range pathDevices {
+			if err = os.WriteFile(path.Join("/sys", "class", "block", blockdevice, "device", "delete"), []byte("1"), 0); err != nil {
+				return
+			}
+		}
+
+		return ns.iscsiForgetLun(target, lun)
+	}
+
 	if err = iscsiLogoutNode(ctx, target); err != nil {
 		return
 	}
 
-	return iscsiDeleteNode(ctx, target)
+	if err = iscsiDeleteNode(ctx, target); err != nil {
+		return
+	}
+
+	return ns.iscsiForgetLun(target, lun)
+}
+
+// iscsiPathDevices returns the block device names of the paths of a staged device
+func iscsiPathDevices(device string) []string {
+	dev, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return nil
+	}
+
+	blockdevice := path.Base(dev)
+	if slaves, _ := os.ReadDir(path.Join("/sys", "class", "block", blockdevice, "slaves")); len(slaves) > 0 {
+		names := make([]string, len(slaves))
+		for i, slave := range slaves {
+			names[i] = slave.Name()
+		}
+
+		return names
+	}
+
+	return []string{blockdevice}
+}
+
+// iscsiTargetInUse returns true if luns of target other than lun are staged on the node.
+// Devices do not tell this, as a rescan attaches all luns of the target.
+func (ns *server) iscsiTargetInUse(target string, lun int) bool {
+	entries, _ := os.ReadDir(path.Join(ns.iscsiStateDir, target))
+	for _, entry := range entries {
+		if entry.Name() != strconv.Itoa(lun) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// iscsiLunRecorded returns true if lun of target is recorded as staged on the node
+func (ns *server) iscsiLunRecorded(target string, lun int) bool {
+	_, err := os.Stat(path.Join(ns.iscsiStateDir, target, strconv.Itoa(lun)))
+
+	return err == nil
+}
+
+// iscsiRecordLun records lun of target as staged on the node
+func (ns *server) iscsiRecordLun(target string, lun int) error {
+	dir := path.Join(ns.iscsiStateDir, target)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path.Join(dir, strconv.Itoa(lun)), nil, 0o640)
+}
+
+// iscsiForgetLun removes the record of lun of target, and the record of the target with its last lun
+func (ns *server) iscsiForgetLun(target string, lun int) error {
+	dir := path.Join(ns.iscsiStateDir, target)
+	if err := os.Remove(path.Join(dir, strconv.Itoa(lun))); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// Fails while other luns are recorded
+	os.Remove(dir)
+
+	return nil
 }
 
 func (ns *server) publishISCSIVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) error {
@@ -139,6 +342,11 @@ func (ns *server) publishISCSIVolume(ctx context.Context, req *csi.NodePublishVo
 		if err != nil {
 			return status.Errorf(codes.Unavailable, "error reading symlink")
 		}
+		if req.Readonly {
+			if device, err = iscsiReadonlyDevice(ctx, device, req.TargetPath); err != nil {
+				return err
+			}
+		}
 		if oldlink, err := os.Readlink(req.TargetPath); err != nil || device != oldlink {
 			os.Remove(req.TargetPath)
 
@@ -150,7 +358,14 @@ func (ns *server) publishISCSIVolume(ctx context.Context, req *csi.NodePublishVo
 		ismnt, _ := isMountPoint(req.TargetPath)
 		if !ismnt {
 			os.Mkdir(req.TargetPath, 0o755)
-			if err := execCmd(ctx, "mount", path.Join(req.StagingTargetPath, "device"), req.TargetPath); err != nil {
+			// Existing filesystems are detected unless a type is requested
+			var fsType string
+			if mount := req.VolumeCapability.GetMount(); mount.FsType != "" {
+				fsType = iscsiFsType(mount)
+			}
+
+			options := mountOptions(req.VolumeCapability, req.Readonly)
+			if err := execCmd(ctx, "mount", mountArgs(path.Join(req.StagingTargetPath, "device"), req.TargetPath, fsType, options)...); err != nil {
 				return status.Errorf(codes.Unavailable, "Error mounting filesystem: %+v", err)
 			}
 		}
@@ -161,6 +376,55 @@ func (ns *server) publishISCSIVolume(ctx context.Context, req *csi.NodePublishVo
 	return nil
 }
 
+// iscsiReadonlyDevicePrefix is the prefix of device mapper names of read-only block publishes
+const iscsiReadonlyDevicePrefix = "truenas-csi-ro-"
+
+// iscsiReadonlyDeviceName returns the device mapper name of a read-only block publish at targetPath
+func iscsiReadonlyDeviceName(targetPath string) string {
+	sum := sha1.Sum([]byte(targetPath))
+
+	return iscsiReadonlyDevicePrefix + hex.EncodeToString(sum[:8])
+}
+
+// iscsiReadonlyDevice returns a read-only mapping of device for a read-only block publish at
+// targetPath. Each read-only publish gets its own mapping, the shared device stays writable for
+// other publishes on the node.
+func iscsiReadonlyDevice(ctx context.Context, device string, targetPath string) (string, error) {
+	name := iscsiReadonlyDeviceName(targetPath)
+	mapped := path.Join("/dev/mapper", name)
+	if _, err := os.Stat(mapped); err == nil {
+		return mapped, nil
+	}
+
+	dev, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "Error resolving device %q: %+v", device, err)
+	}
+
+	// Size in 512 byte sectors
+	size, err := os.ReadFile(path.Join("/sys", "class", "block", path.Base(dev), "size"))
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "Error reading size of %q: %+v", dev, err)
+	}
+
+	table := fmt.Sprintf("0 %s linear %s 0", strings.TrimSpace(string(size)), dev)
+	if err = execCmd(ctx, "dmsetup", "create", name, "--readonly", "--table", table); err != nil {
+		return "", status.Errorf(codes.Unavailable, "Error creating read-only mapping of %q: %+v", dev, err)
+	}
+
+	return mapped, nil
+}
+
+// iscsiRemoveReadonlyDevice removes the read-only mapping of a block publish at targetPath, if any
+func iscsiRemoveReadonlyDevice(ctx context.Context, targetPath string) error {
+	name := iscsiReadonlyDeviceName(targetPath)
+	if _, err := os.Stat(path.Join("/dev/mapper", name)); err != nil {
+		return nil
+	}
+
+	return execCmd(ctx, "dmsetup", "remove", name)
+}
+
 func (ns *server) iscsiNodeExpandVolume(ctx context.Context, req *csi.NodeExpandVolumeRequest) (*csi.NodeExpandVolumeResponse, error) {
 	if req.StagingTargetPath == "" {
 		return nil, status.Errorf(codes.NotFound, "StagingTargetPath not provided")
@@ -177,9 +441,33 @@ func (ns *server) iscsiNodeExpandVolume(ctx context.Context, req *csi.NodeExpand
 
 	blockdevice := strings.TrimPrefix(device, "/dev/")
 
-	rescanPath := path.Join("/sys", "class", "block", blockdevice, "device", "rescan")
-	if err = os.WriteFile(rescanPath, []byte("- - -"), 0); err != nil {
-		return nil, status.Errorf(codes.Unavailable, "Failed issuing rescan to %s: %+v", rescanPath, err)
+	// Multipath devices are rescanned through all of their paths
+	slaves, _ := os.ReadDir(path.Join("/sys", "class", "block", blockdevice, "slaves"))
+
+	blockdevices := []string{blockdevice}
+	if len(slaves) > 0 {
+		blockdevices = blockdevices[:0]
+		for _, slave := range slaves {
+			blockdevices = append(blockdevices, slave.Name())
+		}
+	}
+
+	for _, blockdevice := range blockdevices {
+		rescanPath := path.Join("/sys", "class", "block", blockdevice, "device", "rescan")
+		if err = os.WriteFile(rescanPath, []byte("- - -"), 0); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Failed issuing rescan to %s: %+v", rescanPath, err)
+		}
+	}
+
+	if len(slaves) > 0 {
+		name, err := os.ReadFile(path.Join("/sys", "class", "block", blockdevice, "dm", "name"))
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Failed reading multipath map name of %s: %+v", device, err)
+		}
+
+		if err = execCmd(ctx, "multipathd", "resize", "map", strings.TrimSpace(string(name))); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Failed resizing multipath map of %s: %+v", device, err)
+		}
 	}
 
 	ismnt, _ := isMountPoint(req.VolumePath)
@@ -216,8 +504,10 @@ var defaultNodeSettings = []nodeSetting{
 }
 
 func iscsiAddNode(ctx context.Context, iscsi *volumecontext.ISCSI) (err error) {
-	if err = iscsiadm(ctx, "-m", "node", "-T", iscsi.Target, "-o", "new", "-p", iscsi.Portal); err != nil {
-		return
+	for _, portal := range iscsiPortals(iscsi) {
+		if err = iscsiadm(ctx, "-m", "node", "-T", iscsi.Target, "-o", "new", "-p", portal); err != nil {
+			return
+		}
 	}
 
 	settings := defaultNodeSettings
@@ -245,6 +535,52 @@ func iscsiAddNode(ctx context.Context, iscsi *volumecontext.ISCSI) (err error) {
 	return nil
 }
 
+// Node stage secret keys holding iscsi discovery credentials
+const (
+	iscsiDiscoveryUsernameSecret     = "discoveryUsername"
+	iscsiDiscoveryPasswordSecret     = "discoveryPassword"
+	iscsiDiscoveryPeerUsernameSecret = "discoveryPeerUsername"
+	iscsiDiscoveryPeerPasswordSecret = "discoveryPeerPassword"
+)
+
+// iscsiDiscover performs an authenticated sendtargets discovery on each portal, without creating node records.
+// Credentials are taken from node stage secrets.
+func iscsiDiscover(ctx context.Context, iscsi *volumecontext.ISCSI, secrets map[string]string) (err error) {
+	if secrets[iscsiDiscoveryUsernameSecret] == "" || secrets[iscsiDiscoveryPasswordSecret] == "" {
+		return status.Errorf(codes.InvalidArgument, "iscsi discovery requires %q and %q in node stage secrets", iscsiDiscoveryUsernameSecret, iscsiDiscoveryPasswordSecret)
+	}
+
+	settings := []nodeSetting{
+		{"discovery.sendtargets.auth.authmethod", "CHAP"},
+		{"discovery.sendtargets.auth.username", secrets[iscsiDiscoveryUsernameSecret]},
+		{"discovery.sendtargets.auth.password", secrets[iscsiDiscoveryPasswordSecret]},
+	}
+	if secrets[iscsiDiscoveryPeerUsernameSecret] != "" {
+		settings = append(settings,
+			nodeSetting{"discovery.sendtargets.auth.username_in", secrets[iscsiDiscoveryPeerUsernameSecret]},
+			nodeSetting{"discovery.sendtargets.auth.password_in", secrets[iscsiDiscoveryPeerPasswordSecret]},
+		)
+	}
+
+	for _, portal := range iscsiPortals(iscsi) {
+		if err = iscsiadm(ctx, "-m", "discoverydb", "-t", "st", "-p", portal, "-o", "new"); err != nil {
+			return
+		}
+
+		for _, setting := range settings {
+			if err = iscsiadm(ctx, "-m", "discoverydb", "-t", "st", "-p", portal, "-o", "update", "-n", setting.Key, "-v", setting.Value); err != nil {
+				return
+			}
+		}
+
+		if err = iscsiadm(ctx, "-m", "discoverydb", "-t", "st", "-p", portal, "--discover", "-o", "nonpersistent"); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
 func iscsiDeleteNode(ctx context.Context, target string) (err error) {
 	return passExitCode(iscsiadm(ctx, "-m", "node", "-T", target, "-o", "delete"), ISCSI_ERR_NO_OBJS_FOUND)
 }
@@ -253,6 +589,10 @@ func iscsiLoginNode(ctx context.Context, target string) (err error) {
 	return passExitCode(iscsiadm(ctx, "-m", "node", "-T", target, "-l"), ISCSI_ERR_SESS_EXISTS)
 }
 
+func iscsiRescanNode(ctx context.Context, target string) (err error) {
+	return iscsiadm(ctx, "-m", "node", "-T", target, "-R")
+}
+
 func iscsiLogoutNode(ctx context.Context, target string) (err error) {
 	return passExitCode(iscsiadm(ctx, "-m", "node", "-T", target, "-u"), ISCSI_ERR_NO_OBJS_FOUND)
 }
@@ -277,6 +617,55 @@ func iscsiWaitForDevice(ctx context.Context, device string) (err error) {
 	}
 }
 
+// iscsiWaitForMultipathDevice returns the multipath device a path device is part of,
+// waiting for multipathd to set it up
+func iscsiWaitForMultipathDevice(ctx context.Context, device string) (string, error) {
+	dev, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return "", err
+	}
+	holders := path.Join("/sys", "class", "block", path.Base(dev), "holders")
+
+	tcontext, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		if entries, _ := os.ReadDir(holders); len(entries) > 0 {
+			name, err := os.ReadFile(path.Join("/sys", "class", "block", entries[0].Name(), "dm", "name"))
+			if err == nil {
+				return path.Join("/dev/mapper", strings.TrimSpace(string(name))), nil
+			}
+		}
+
+		select {
+		case <-tcontext.Done():
+			return "", fmt.Errorf("Waiting for multipath device of %s timed out", device)
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReadInitiatorName returns the iSCSI initiator name from an initiatorname.iscsi file
+func ReadInitiatorName(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+
+		if name, ok := strings.CutPrefix(line, "InitiatorName="); ok {
+			return strings.TrimSpace(name), nil
+		}
+	}
+
+	return "", fmt.Errorf("no InitiatorName found in %s", file)
+}
+
 var iscsiMu sync.Mutex
 
 func iscsiadm(ctx context.Context, args ...string) (err error) {
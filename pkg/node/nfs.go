@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"google.golang.org/grpc/codes"
@@ -16,8 +17,24 @@ func (ns *server) publishNFSVolume(ctx context.Context, req *csi.NodePublishVolu
 	case req.VolumeCapability.GetMount() != nil:
 		ismnt, _ := isMountPoint(req.TargetPath)
 		if !ismnt {
+			var options []string
+			if nfs.Security != "" {
+				// Kerberos requires NFSv4
+				if err := ns.startGssd(); err != nil {
+					return status.Errorf(codes.Unavailable, "Error starting rpc.gssd: %+v", err)
+				}
+				version := nfs.Version
+				if version == "" {
+					version = "4.1"
+				}
+				options = append(options, fmt.Sprintf("vers=%s,sec=%s", version, nfs.Security))
+			}
+			// Requested mount flags take precedence over configured defaults
+			options = append(options, nfs.MountOptions...)
+			options = append(options, mountOptions(req.VolumeCapability, req.Readonly)...)
+
 			os.Mkdir(req.TargetPath, 0o755)
-			if err := execCmd(ctx, "mount", nfs.Address, req.TargetPath); err != nil {
+			if err := execCmd(ctx, "mount", mountArgs(nfs.Address, req.TargetPath, "nfs", options)...); err != nil {
 				return status.Errorf(codes.Unavailable, "Error mounting filesystem: %+v", err)
 			}
 		}
@@ -27,3 +44,30 @@ func (ns *server) publishNFSVolume(ctx context.Context, req *csi.NodePublishVolu
 
 	return nil
 }
+
+// startGssd starts rpc.gssd with the configured keytab, needed for kerberized mounts.
+// Without a keytab, an rpc.gssd running on the host is relied on.
+func (ns *server) startGssd() error {
+	if ns.nfsKeytab == "" {
+		return nil
+	}
+
+	ns.gssdMu.Lock()
+	defer ns.gssdMu.Unlock()
+
+	if ns.gssdStarted {
+		return nil
+	}
+
+	if _, err := os.Stat(ns.nfsKeytab); err != nil {
+		return err
+	}
+
+	// rpc.gssd daemonizes itself
+	if err := execCmd(context.Background(), "rpc.gssd", "-k", ns.nfsKeytab); err != nil {
+		return err
+	}
+	ns.gssdStarted = true
+
+	return nil
+}
@@ -8,6 +8,9 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -20,7 +23,24 @@ import (
 )
 
 type server struct {
-	nodeId string
+	nodeId   string
+	topology map[string]string
+
+	// nfsKeytab is the keytab rpc.gssd is started with for kerberized nfs mounts
+	nfsKeytab   string
+	gssdMu      sync.Mutex
+	gssdStarted bool
+
+	// provisioner creates inline ephemeral volumes, nil if not enabled
+	provisioner  csi.ControllerServer
+	ephemeralDir string
+	// ephemeralMaxSize limits the size of inline volumes, unlimited if 0
+	ephemeralMaxSize int64
+	// ephemeralAttributes lists volume attributes inline volumes may set besides size
+	ephemeralAttributes []string
+
+	// iscsiStateDir records luns staged on the node, per target
+	iscsiStateDir string
 
 	csi.UnimplementedNodeServer
 }
@@ -49,7 +69,7 @@ func (ns *server) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolumeR
 
 	if req.VolumeCapability.GetBlock() != nil {
 		if volumeContext.Iscsi == nil {
-			return nil, status.Error(codes.InvalidArgument, "Cannot publish nfs volume as block")
+			return nil, status.Error(codes.InvalidArgument, "Cannot publish filesystem volume as block")
 		}
 	}
 
@@ -59,6 +79,12 @@ func (ns *server) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolumeR
 		}
 	}
 
+	if volumeContext.Smb != nil {
+		if err = ns.stageSMBVolume(ctx, req, volumeContext.Smb); err != nil {
+			return nil, err
+		}
+	}
+
 	return &csi.NodeStageVolumeResponse{}, nil
 }
 
@@ -76,12 +102,31 @@ func (ns *server) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstageVol
 	iscsiFile := path.Join(req.StagingTargetPath, "iscsi")
 
 	if targetb, err := os.ReadFile(iscsiFile); err == nil {
-		ns.unstageISCSIVolume(ctx, string(targetb))
+		// Volumes staged without a lun file use lun 0
+		lunFile := path.Join(req.StagingTargetPath, "lun")
+		var lun int
+		if lunb, err := os.ReadFile(lunFile); err == nil {
+			lun, _ = strconv.Atoi(string(lunb))
+		}
+
 		rdevFile := path.Join(req.StagingTargetPath, "device")
+		device, _ := os.Readlink(rdevFile)
+		// State is kept on failure, so that unstage is retried
+		if err = ns.unstageISCSIVolume(ctx, string(targetb), lun, device); err != nil {
+			return nil, status.Errorf(codes.Internal, "Error unstaging iscsi volume: %+v", err)
+		}
 		os.Remove(rdevFile)
+		os.Remove(lunFile)
 		os.Remove(iscsiFile)
 	}
 
+	// smb shares are mounted at the staging path
+	if ismnt, _ := isMountPoint(req.StagingTargetPath); ismnt {
+		if _, err := umount(ctx, req.StagingTargetPath); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Umount failed: %+v", err)
+		}
+	}
+
 	return &csi.NodeUnstageVolumeResponse{}, nil
 }
 
@@ -100,6 +145,11 @@ func (ns *server) NodePublishVolume(ctx context.Context, req *csi.NodePublishVol
 		return nil, status.Error(codes.InvalidArgument, "VolumeCapability not provided")
 	}
 
+	// Inline volumes are not staged by the CO
+	if isEphemeral(req) {
+		return ns.publishEphemeralVolume(ctx, req)
+	}
+
 	if req.StagingTargetPath == "" {
 		return nil, status.Error(codes.FailedPrecondition, "StagingTargetPath not set")
 	}
@@ -117,6 +167,8 @@ func (ns *server) NodePublishVolume(ctx context.Context, req *csi.NodePublishVol
 		err = ns.publishNFSVolume(ctx, req, volumeContext.Nfs)
 	} else if volumeContext.Iscsi != nil {
 		err = ns.publishISCSIVolume(ctx, req)
+	} else if volumeContext.Smb != nil {
+		err = ns.publishSMBVolume(ctx, req)
 	}
 
 	if err != nil {
@@ -157,6 +209,14 @@ func (ns *server) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpublis
 
 	os.Remove(req.TargetPath)
 
+	if err := iscsiRemoveReadonlyDevice(ctx, req.TargetPath); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error removing read-only device mapping: %+v", err)
+	}
+
+	if err := ns.unpublishEphemeralVolume(ctx, req.VolumeId); err != nil {
+		return nil, err
+	}
+
 	return &csi.NodeUnpublishVolumeResponse{}, nil
 }
 
@@ -198,12 +258,12 @@ func (ns *server) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolume
 	} else if stat.Mode&unix.S_IFBLK == unix.S_IFBLK {
 		dev, err := os.Open(req.VolumePath)
 		if err != nil {
-			return nil, status.Errorf(codes.Unavailable, "failed opening device %q: %s", dev, err)
+			return nil, status.Errorf(codes.Unavailable, "failed opening device %q: %s", req.VolumePath, err)
 		}
 		defer dev.Close()
 		size, err := dev.Seek(0, 2)
 		if err != nil {
-			return nil, status.Errorf(codes.Unavailable, "failed seeking to end of device %q: %s", dev, err)
+			return nil, status.Errorf(codes.Unavailable, "failed seeking to end of device %q: %s", req.VolumePath, err)
 		}
 
 		return &csi.NodeGetVolumeStatsResponse{
@@ -258,19 +318,32 @@ func (ns *server) NodeGetCapabilities(context.Context, *csi.NodeGetCapabilitiesR
 					},
 				},
 			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_VOLUME_MOUNT_GROUP,
+					},
+				},
+			},
 		},
 	}, nil
 }
 
 func (ns *server) NodeGetInfo(context.Context, *csi.NodeGetInfoRequest) (*csi.NodeGetInfoResponse, error) {
-	return &csi.NodeGetInfoResponse{
+	resp := &csi.NodeGetInfoResponse{
 		NodeId: ns.nodeId,
-	}, nil
+	}
+
+	if len(ns.topology) > 0 {
+		resp.AccessibleTopology = &csi.Topology{Segments: ns.topology}
+	}
+
+	return resp, nil
 }
 
 // New returns csi.NodeServer
-func New(nodeId string) csi.NodeServer {
-	return &server{nodeId: nodeId}
+func New(nodeId string, topology map[string]string, nfsKeytab string, provisioner csi.ControllerServer, ephemeralDir string, ephemeralMaxSize int64, ephemeralAttributes []string, iscsiStateDir string) csi.NodeServer {
+	return &server{nodeId: nodeId, topology: topology, nfsKeytab: nfsKeytab, provisioner: provisioner, ephemeralDir: ephemeralDir, ephemeralMaxSize: ephemeralMaxSize, ephemeralAttributes: ephemeralAttributes, iscsiStateDir: iscsiStateDir}
 }
 
 func execCmd(ctx context.Context, name string, arg ...string) error {
@@ -289,6 +362,39 @@ func passExitCode(err error, code int) error {
 	return err
 }
 
+// mountOptions returns mount options requested by capability, and ro for read-only access
+func mountOptions(capability *csi.VolumeCapability, readonly bool) []string {
+	options := append([]string{}, capability.GetMount().GetMountFlags()...)
+	if readonly {
+		options = append(options, "ro")
+	}
+
+	return options
+}
+
+// isReaderOnly returns true if capability grants read-only access to the volume
+func isReaderOnly(capability *csi.VolumeCapability) bool {
+	switch capability.GetAccessMode().GetMode() {
+	case csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY, csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY:
+		return true
+	}
+
+	return false
+}
+
+// mountArgs returns arguments of mount for mounting source at target
+func mountArgs(source, target, fsType string, options []string) []string {
+	var args []string
+	if fsType != "" {
+		args = append(args, "-t", fsType)
+	}
+	if len(options) > 0 {
+		args = append(args, "-o", strings.Join(options, ","))
+	}
+
+	return append(args, source, target)
+}
+
 func umount(ctx context.Context, path string) (busy bool, err error) {
 	if err = syscall.Unmount(path, 0); err != nil {
 		var errno syscall.Errno
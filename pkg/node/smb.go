@@ -0,0 +1,117 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dravanet/truenas-csi/pkg/csi"
+	"github.com/dravanet/truenas-csi/pkg/volumecontext"
+)
+
+// Node stage secret keys holding smb credentials
+const (
+	smbUsernameSecret = "username"
+	smbPasswordSecret = "password"
+	smbDomainSecret   = "domain"
+)
+
+// smbAddressRe matches share addresses in //server/share form
+var smbAddressRe = regexp.MustCompile(`^//([A-Za-z0-9.-]+|\[[0-9A-Fa-f:.]+\])/[^/\\,\s]+$`)
+
+// stageSMBVolume mounts an smb share at the staging path, using credentials from node stage secrets
+func (ns *server) stageSMBVolume(ctx context.Context, req *csi.NodeStageVolumeRequest, smb *volumecontext.SMB) error {
+	if ismnt, _ := isMountPoint(req.StagingTargetPath); ismnt {
+		return nil
+	}
+
+	if !smbAddressRe.MatchString(smb.Address) {
+		return status.Errorf(codes.InvalidArgument, "Invalid smb share address: %q", smb.Address)
+	}
+
+	if req.Secrets[smbUsernameSecret] == "" {
+		return status.Errorf(codes.InvalidArgument, "smb volumes require %q in node stage secrets", smbUsernameSecret)
+	}
+
+	// Credentials are passed in a file, so that they are neither parsed as mount options,
+	// nor show up in process listings
+	credentials, err := writeSMBCredentials(req.Secrets)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(credentials)
+
+	options := []string{fmt.Sprintf("credentials=%s", credentials)}
+	if group := req.VolumeCapability.GetMount().GetVolumeMountGroup(); group != "" {
+		if _, err = strconv.ParseUint(group, 10, 32); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid volume mount group: %q", group)
+		}
+		options = append(options, fmt.Sprintf("gid=%s", group), "forcegid", "dir_mode=0775", "file_mode=0664")
+	}
+	// Read-only access is applied at publish
+	options = append(options, mountOptions(req.VolumeCapability, false)...)
+
+	if err = execCmd(ctx, "mount", mountArgs(smb.Address, req.StagingTargetPath, "cifs", options)...); err != nil {
+		return status.Errorf(codes.Unavailable, "Error mounting smb share: %+v", err)
+	}
+
+	return nil
+}
+
+// writeSMBCredentials writes credentials from secrets into a temporary file readable by root only,
+// returning its path
+func writeSMBCredentials(secrets map[string]string) (string, error) {
+	var content strings.Builder
+	for _, key := range []string{smbUsernameSecret, smbPasswordSecret, smbDomainSecret} {
+		value, ok := secrets[key]
+		if !ok {
+			continue
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return "", status.Errorf(codes.InvalidArgument, "Node stage secret %q must not contain line breaks", key)
+		}
+		fmt.Fprintf(&content, "%s=%s\n", key, value)
+	}
+
+	f, err := os.CreateTemp("", "smb-credentials-")
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "Error creating smb credentials file: %+v", err)
+	}
+	defer f.Close()
+
+	if _, err = f.WriteString(content.String()); err != nil {
+		os.Remove(f.Name())
+		return "", status.Errorf(codes.Unavailable, "Error writing smb credentials file: %+v", err)
+	}
+
+	return f.Name(), nil
+}
+
+// publishSMBVolume bind-mounts the staged smb share to the target path
+func (ns *server) publishSMBVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) error {
+	switch {
+	case req.VolumeCapability.GetMount() != nil:
+		ismnt, _ := isMountPoint(req.TargetPath)
+		if !ismnt {
+			os.Mkdir(req.TargetPath, 0o755)
+			options := []string{"bind"}
+			if req.Readonly {
+				options = append(options, "ro")
+			}
+
+			if err := execCmd(ctx, "mount", mountArgs(req.StagingTargetPath, req.TargetPath, "", options)...); err != nil {
+				return status.Errorf(codes.Unavailable, "Error mounting filesystem: %+v", err)
+			}
+		}
+	default:
+		return status.Errorf(codes.FailedPrecondition, "Invalid configuration requested")
+	}
+
+	return nil
+}
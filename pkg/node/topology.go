@@ -0,0 +1,60 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ParseTopology parses topology segments in key=value[,key=value...] format
+func ParseTopology(s string) (map[string]string, error) {
+	segments := make(map[string]string)
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(item, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid topology segment: %q", item)
+		}
+
+		segments[key] = value
+	}
+
+	return segments, nil
+}
+
+// ReadTopologyFile reads topology segments from a file holding key=value lines,
+// where values may be quoted, like a downward API labels file.
+// Only keys listed in keys are returned.
+func ReadTopologyFile(file string, keys []string) (map[string]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make(map[string]string)
+
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+
+		for _, k := range keys {
+			if k == key {
+				segments[key] = value
+			}
+		}
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,54 @@
+package nodeid
+
+import (
+	"strings"
+)
+
+// separator separates fields of a node id
+const separator = "@"
+
+// MaxLength is the maximum length of a node id allowed by the CSI spec
+const MaxLength = 256
+
+// Node holds node information carried in a node id
+type Node struct {
+	// Name is the node's name
+	Name string
+
+	// Address is the address to grant access to shares for
+	Address string
+
+	// IQN is the node's iSCSI initiator name
+	IQN string
+}
+
+// String returns the node id of node. Empty trailing fields are omitted.
+func (n *Node) String() string {
+	fields := []string{n.Name, n.Address, n.IQN}
+
+	for len(fields) > 1 && fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+
+	return strings.Join(fields, separator)
+}
+
+// Parse returns the node information carried in nodeID. If the node id carries
+// no address, the node name is used as address.
+func Parse(nodeID string) *Node {
+	fields := strings.SplitN(nodeID, separator, 3)
+
+	n := &Node{Name: fields[0]}
+	if len(fields) > 1 {
+		n.Address = fields[1]
+	}
+	if len(fields) > 2 {
+		n.IQN = fields[2]
+	}
+
+	if n.Address == "" {
+		n.Address = n.Name
+	}
+
+	return n
+}
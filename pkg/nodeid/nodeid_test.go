@@ -0,0 +1,29 @@
+package nodeid
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		node Node
+		id   string
+	}{
+		{Node{Name: "node1"}, "node1"},
+		{Node{Name: "node1", Address: "10.0.0.1"}, "node1@10.0.0.1"},
+		{Node{Name: "node1", Address: "10.0.0.1", IQN: "iqn.2004-10.com.ubuntu:01:abc"}, "node1@10.0.0.1@iqn.2004-10.com.ubuntu:01:abc"},
+		{Node{Name: "node1", IQN: "iqn.2004-10.com.ubuntu:01:abc"}, "node1@@iqn.2004-10.com.ubuntu:01:abc"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.node.String(); got != tt.id {
+			t.Errorf("String() = %q, want %q", got, tt.id)
+		}
+
+		want := tt.node
+		if want.Address == "" {
+			want.Address = want.Name
+		}
+		if got := Parse(tt.id); *got != want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.id, *got, want)
+		}
+	}
+}
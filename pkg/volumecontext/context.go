@@ -5,6 +5,7 @@ type VolumeContext struct {
 	// Dataset string `json:"dataset"`
 	Iscsi *ISCSI `json:"iscsi,omitempty"`
 	Nfs   *NFS   `json:"nfs,omitempty"`
+	Smb   *SMB   `json:"smb,omitempty"`
 }
 
 // ISCSI represents ISCSI connection parameters
@@ -12,8 +13,17 @@ type ISCSI struct {
 	Portal string `json:"portal"`
 	Target string `json:"target"`
 
+	// Lun is the lun of the volume within target
+	Lun int `json:"lun,omitempty"`
+
+	// Portals are additional portals, volumes are accessed through multipath
+	Portals []string `json:"portals,omitempty"`
+
 	InboundAuth  *ISCSIAuth `json:"inAuth,omitempty"`
 	OutboundAuth *ISCSIAuth `json:"outAuth,omitempty"`
+
+	// DiscoveryAuth means nodes discover targets before login, with credentials from node stage secrets
+	DiscoveryAuth bool `json:"discoveryAuth,omitempty"`
 }
 
 // ISCSIAuth holds ISCSI authentication parameters
@@ -25,4 +35,18 @@ type ISCSIAuth struct {
 // NFS holds nfs parameters
 type NFS struct {
 	Address string `json:"address"`
+
+	// Security is the kerberos security flavor to mount with, if any
+	Security string `json:"security,omitempty"`
+
+	// Version is the NFSv4 minor version to mount kerberized shares with, 4.1 if empty
+	Version string `json:"version,omitempty"`
+
+	// MountOptions are default options to mount with
+	MountOptions []string `json:"mountOptions,omitempty"`
+}
+
+// SMB holds smb parameters
+type SMB struct {
+	Address string `json:"address"`
 }